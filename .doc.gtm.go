@@ -22,6 +22,15 @@
 
 <!--- gotomd::snip::./internal/.directives.sds.md # START SNIPPET -->
 
+# Exit Status
+
+The program exits with `0` on success, `1` if processing fails and `2` if
+`--uptodate` finds documentation that would be changed.
+
+<!--- gotomd::run::expect-exit=1 ./. --permission 0644 --uptodate -->
+
+<!--- gotomd::run::expect-exit=2 ./. --uptodate ./internal/testdata/stale -->
+
 # Dedication
 
 <!--- gotomd::irun::./. --Reem -->
@@ -24,7 +24,9 @@
 /*
 	usage: gotomd [-v | --verbose ...] [-d | --directive] [-l | --license]
 	              [-h | --help] [-f | --force] [-u | --uptodate]
-	              [-o | --output <dir>] [-p | --permission <perm>] [path ...]
+	              [-o | --output <dir>] [-p | --permission <perm>]
+	              [--tags <tags>] [--goos <os>] [--goarch <arch>]
+	              [--timeout <duration>] [path ...]
 
 	Synchronize Go package and GitHub style README.md documentation by embedding
 	Go documentation, source code, test and command output directly from the Go
@@ -62,11 +64,29 @@
 
 	        (can only set RW bits).
 
+	    [--tags <tags>]
+	        Comma separated build tags used when loading packages for
+	        documentation. Directives may override this with a 'tags=' option.
+
+	    [--goos <os>]
+	        Target operating system (GOOS) used when loading packages for
+	        documentation. Directives may override this with a 'goos=' option.
+
+	    [--goarch <arch>]
+	        Target architecture (GOARCH) used when loading packages for
+	        documentation. Directives may override this with a 'goarch=' option.
+
+	    [--timeout <duration>]
+	        Maximum time (such as '90s' or '5m') allowed for each command run or
+	        package load. Directives may override this with a 'timeout=' option.
+	        Defaults to '10m'.
+
 	    [path ...]
 	        Specific template files (named like '.*.gtm.md' or '.*.gtm.go') or a
 	        directory which will be searched for all matching template files.
-	        All subdirectories may be searched by using the special './...'
-	        path. It defaults to search the current directory: '.'
+	        All subdirectories may be searched by using the special './...' path
+	        which also follows all modules used by a go.work file in the
+	        directory. It defaults to search the current directory: '.'
 
 # Directives
 
@@ -98,19 +118,129 @@ content corresponding to that directive.
 
 Available actions are:
 
+   - `apidiff` records the exported API in a snapshot reporting any changes
+   - `badge` writes a locally computed SVG status badge and links to it
+   - `bench` runs benchmarks and tabulates (or compares) their results
+   - `callgraph` renders the functions called by (or calling) a function
+   - `coverage` runs the tests and tabulates the coverage of each function
    - `doc`   runs and embeds output from `go doc` for a package object
    - `dcl`   inserts the declaration of package objects
    - `dclg`  inserts the declaration group for package objects (IE `const` blocks)
    - `dcln`  inserts the declaration exactly as defined in source including comments
    - `dcls`  inserts the declaration formatted as a single line
+   - `deprecated` renders a migration table of deprecated identifiers
+   - `deps`  renders the package import graph as a Mermaid (or DOT) diagram
+   - `doccov` reports exported identifiers missing conventional doc comments
+   - `exec`  runs an allow-listed command and frames its output
+   - `file`  includes a text file of any type fenced with its inferred language
+   - `func`  inserts the complete source of a function or method
+   - `fuzz`  tabulates a fuzz target's seed and recorded corpus (optionally fuzzing it)
    - `irun`  runs the package and inserts the output without decorations
    - `run`   runs the package and frames the output with the command executed
+   - `session` runs several commands in a scratch directory as a terminal transcript
    - `snip`  includes an external snippet expanding any embedded directives
    - `src`   includes a Go source file
    - `tst`   runs a Go test (or all tests) in a package
    - `tstc`  Runs a go test (or all tests) and converts output to TeX to preserve formatting
 
-### Action: doc
+### Action: apidiff
+
+Records the exported API of the packages in the specified directories into
+a checked-in snapshot file and reports any differences from the API
+previously recorded there.  Additions are compatible while removed or
+changed declarations, and methods added to an existing interface, are
+flagged as `INCOMPATIBLE`.
+
+	<!--- gotomd::apidiff::./directory/. [./other/.] snapshot=./api.txt -->
+
+The following options are supported:
+
+   | option     | default | description                                     |
+   | ---------- | ------- | ----------------------------------------------- |
+   | `snapshot` |         | required local path of the snapshot file        |
+   | `show`     | `true`  | embed the current API listing in the document   |
+   | `tags`     |         | build tags used when loading the packages       |
+   | `goos`     |         | target operating system                         |
+   | `goarch`   |         | target architecture                             |
+
+The snapshot is updated like any other generated file so running with `-u`
+exits with status 2 whenever an API change has not yet been recorded.
+
+### Action: badge
+
+Computes a status badge locally (without any external badge service so it
+works offline and for private repositories), writes it as an SVG file next
+to the generated document and inserts a relative image link to it.
+
+	<!--- gotomd::badge::coverage ./directory/... [option=value ...] -->
+	<!--- gotomd::badge::tests ./directory/... [option=value ...] -->
+	<!--- gotomd::badge::go [option=value ...] -->
+	<!--- gotomd::badge::license [option=value ...] -->
+
+The following badges are supported:
+
+   | badge      | value                                                     |
+   | ---------- | --------------------------------------------------------- |
+   | `coverage` | total statement coverage of the package (`.`) or packages (`...`) |
+   | `tests`    | number of tests (including subtests) passed or failed     |
+   | `go`       | Go version declared by the module's `go.mod`              |
+   | `license`  | license identified from the module's `LICENSE` file       |
+
+The following options are supported:
+
+   | option       | default           | description                           |
+   | ------------ | ----------------- | ------------------------------------- |
+   | `file`       | `badge-KIND.svg`  | local path of the SVG file written    |
+   | `label`      | badge kind        | text shown on the left of the badge   |
+   | `thresholds` | `50,80`           | coverage below which the badge is red and yellow (otherwise green) |
+   | `timeout`    | `10m`             | maximum run time (see Timeouts)       |
+
+The SVG file is updated like any other generated file so running with `-u`
+exits with status 2 whenever a badge has changed.
+
+Statically computes the functions called by (`callees`) or calling
+(`callers`) the named function or method (`Type.Method`) within its module
+and renders the excerpt as a Mermaid flowchart or as an indented list.
+
+	<!--- gotomd::callgraph::./directory/Function [option=value ...] -->
+
+	<!--- gotomd::callgraph::./directory/Type.Method format=list depth=3 -->
+
+The following options are supported:
+
+   | option      | default   | description                               |
+   | ----------- | --------- | ----------------------------------------- |
+   | `depth`     | `2`       | number of call levels followed            |
+   | `direction` | `callees` | follow `callees` or `callers`             |
+   | `format`    | `mermaid` | render as a `mermaid` flowchart or `list` |
+
+Only functions belonging to the module are shown.  Recursive calls are
+marked in lists rather than followed.
+
+### Action: coverage
+
+Runs the tests of the package in the specified directory (`.`) or of every
+package below it (`...`) with a coverage profile and renders the statement
+coverage of each function, computed the same way as `go tool cover -func`,
+as a table ending with the overall total.
+
+	<!--- gotomd::coverage::./directory/... [option=value ...] -->
+
+The following options are supported:
+
+   | option     | default | description                                    |
+   | ---------- | ------- | ---------------------------------------------- |
+   | `sort`     | `file`  | order rows by `file`, `name` or `coverage`     |
+   | `min`      | `0`     | minimum overall coverage percentage required   |
+   | `func-min` | `0`     | minimum coverage required of every function    |
+   | `tags`     |         | build tags used when running the tests         |
+   | `goos`     |         | target operating system                        |
+   | `goarch`   |         | target architecture                            |
+   | `timeout`  | `10m`   | maximum run time (see Timeouts)                |
+
+Functions without statements are ignored by `func-min`.  If any function
+falls below `func-min` it is listed and processing fails with a non-zero
+exit status, as it does when the total falls below `min` or any test fails.
 
 Runs `go doc` on the specified object in the given relative package
 directory.
@@ -133,6 +263,9 @@ directory is used.
 	   ./anotherDifferentDirectory/package
 	-->
 
+Objects whose comment contains a `Deprecated:` paragraph are preceded by
+their struck through name and a **DEPRECATED** badge in markdown output.
+
 There are four additional directives all similar to `doc` but focused on object
 declaration formatting as follows:
 
@@ -145,6 +278,19 @@ declaration formatting as follows:
 
 See individual Action sections for more detail.
 
+#### Build constraints
+
+Packages are loaded for the host platform without any build tags unless
+the `--tags`, `--goos` or `--goarch` command line options are given.  Any of
+the `doc` style directives may override these for their objects by adding
+`tags=`, `goos=` or `goarch=` options, so platform specific or tagged
+declarations document the correct variant:
+
+	<!--- gotomd::doc::./directory/goObject tags=integration goos=windows -->
+
+Tags may be separated by commas.  Each distinct combination is loaded and
+cached separately.
+
 ### Action: dcl
 
 Similar to the `doc` directive, `dcl` inserts the declaration of the specified
@@ -194,7 +340,138 @@ Comments are not included.
 	   ...
 	-->
 
-### Action: irun
+### Action: deprecated
+
+Renders a migration table listing every exported identifier of the package
+in the specified directory (`.`) or of every package below it (`...`) whose
+comment contains a `Deprecated:` paragraph.
+
+	<!--- gotomd::deprecated::./directory/... -->
+
+Each row contains the identifier, the deprecation note and, when the note
+names an identifier of the same package either as a doc link (`[NewName]`)
+or as an instruction (`Use NewName`), a link to the replacement's
+declaration in its source file (`./directory/file.go#L12`) relative to the
+document, so private and internal modules are linked as well.  The `tags`,
+`goos` and `goarch` options are supported as for `doc`.
+
+### Action: deps
+
+Renders the import graph of the package in the specified directory (`.`) or
+of every package below it (`...`) as a Mermaid `graph` block which GitHub
+renders natively.
+
+	<!--- gotomd::deps::./directory/... [option=value ...] -->
+
+The following options are supported:
+
+   | option     | default   | description                                    |
+   | ---------- | --------- | ---------------------------------------------- |
+   | `internal` | `false`   | only include packages from the main module(s)  |
+   | `std`      | `true`    | include standard library packages              |
+   | `depth`    | `0`       | maximum import depth followed (`0` = no limit) |
+   | `format`   | `mermaid` | diagram format: `mermaid` or `dot`             |
+
+Packages belonging to the main module are labelled by their path within the
+module.
+
+### Action: doccov
+
+Reports the documentation coverage of the package in the specified directory
+(`.`) or of every package below it (`...`) as a table followed by a list of
+exported identifiers without a doc comment or whose comment does not start
+with the identifier's name as Go convention requires.
+
+	<!--- gotomd::doccov::./directory/... [option=value ...] -->
+
+The following options are supported:
+
+   | option    | default | description                                  |
+   | --------- | ------- | -------------------------------------------- |
+   | `min`     | `0`     | minimum overall coverage percentage required |
+   | `details` | `true`  | list the undocumented identifiers            |
+   | `tags`    |         | build tags used when loading the packages    |
+   | `goos`    |         | target operating system                      |
+   | `goarch`  |         | target architecture                          |
+
+If the overall coverage falls below `min` the undocumented identifiers are
+listed and processing fails with a non-zero exit status.
+
+### Action: exec
+
+Runs an arbitrary command (`make help`, `./scripts/demo.sh`, ...) and frames
+its combined output with the command executed.  The command is split into
+words using shell-like quoting but is run directly without a shell.
+
+	<!--- gotomd::exec::[option=value ...] program [argument ...] -->
+
+The `cwd`, `env`, `stdin`, `timeout`, `expect-exit`, `show-exit`, `output`
+and `as` options described for `run` may precede the command.
+
+For safety the program must be listed in the `exec` allow-list of a
+`.gotomd.json` configuration file found in the template's directory or one
+of its parents up to the module root.  A program given by a path is
+resolved against the `cwd` directory and must match a path listed relative
+to the template's directory:
+
+	{
+	  "exec": {
+	    "allow": ["make", "./scripts/demo.sh"]
+	  }
+	}
+
+### Action: file
+
+Inserts the contents of the specified file of any type (YAML, JSON, SQL,
+shell, proto, Dockerfile, Makefile, ...) fenced with the language inferred
+from its name or extension (`text` if unknown).  Binary files are refused.
+
+	<!--- gotomd::file::./directory/config.yaml [option=value ...] -->
+
+The same line ranges, regions and options as `src` are supported along with
+`lang` overriding the inferred fence language.
+
+	<!--- gotomd::file::./directory/settings.conf#L1-L12 lang=ini -->
+
+### Action: func
+
+Inserts the complete source (including its body) of the named function or
+method (`Type.Method`) in the given relative package directory.  Additional
+objects may be listed as for `doc`.
+
+	<!--- gotomd::func::./directory/Type.Method [option=value ...] -->
+
+The following options are supported:
+
+   | option  | default | description                                       |
+   | ------- | ------- | ------------------------------------------------- |
+   | `doc`   | `false` | precede the source with its doc comment           |
+   | `elide` | `false` | replace function literal bodies with `{ ... }`    |
+
+The `tags`, `goos` and `goarch` options are supported as for `doc`.
+
+### Action: fuzz
+
+Documents the fuzz target in the package directory as a table of its seed
+corpus (the values added with `f.Add`) followed by each file recorded under
+`testdata/fuzz/FuzzX/` with its values decoded.  Columns are named after the
+parameters of the function passed to `f.Fuzz`.
+
+	<!--- gotomd::fuzz::./directory/FuzzX [option=value ...] -->
+
+The following options are supported:
+
+   | option     | default | description                                      |
+   | ---------- | ------- | ------------------------------------------------ |
+   | `fuzztime` |         | fuzz the target first for a time (`10s`) or count (`1000x`) |
+   | `timeout`  | `10m`   | maximum run time (see Timeouts)                  |
+
+When fuzzed, any failure found is reported after the table and the failing
+input Go records in the corpus is marked as a `(new failure)`.  A run that
+fails without recording an input (a build failure or a failing seed) stops
+processing with a non-zero exit status.  Fuzzed results are bracketed as
+volatile so up to date checks ignore them, and the target is not fuzzed
+when only checking.
 
 Runs `go run` on the package in the specified directory (assumes `main`) with
 the provided arguments.
@@ -212,6 +489,141 @@ The output is framed together with the command that was executed.
 
 	<!--- gotomd::run::./directory/. [args ...] -->
 
+Arguments for both `run` and `irun` are split using shell-like quoting
+(single quotes, double quotes and backslash escapes) so they may contain
+spaces, glob characters or even span several lines.  No expansions are
+performed.  The command displayed is re-quoted so that it may be copied and
+run exactly as shown.
+
+	<!--- gotomd::run::./cmd/tool --name "hello world" '*.go' -->
+
+The following options may precede the package for both `run` and `irun`:
+
+   | option        | default    | description                                |
+   | ------------- | ---------- | ------------------------------------------ |
+   | `cwd`         | `.`        | relative directory to run the program in   |
+   | `env`         |            | `KEY=value` added to the environment       |
+   | `stdin`       |            | relative file (or `<<EOF` heredoc) to read |
+   | `timeout`     | `10m`      | maximum run time (see Timeouts)            |
+   | `expect-exit` | `0`        | exit code the program must return          |
+   | `show-exit`   |            | display the exit code (default if not `0`) |
+   | `output`      | `combined` | output streams displayed (see below)       |
+   | `as`          |            | program name displayed instead of `go run` |
+   | `head`        |            | display only the first lines of output     |
+   | `tail`        |            | display only the last lines of output      |
+   | `lines`       |            | display only the range of lines (`5-30`)   |
+   | `grep`        |            | display only lines matching the expression |
+
+The `env` option may be repeated.
+
+	<!--- gotomd::run::env=NAME=world stdin=./testdata/input.txt ./cmd/tool -->
+
+With `stdin=<<EOF` the program's input is taken from the lines of the
+directive following it up to a line containing only `EOF` (any word may be
+used as the marker).  The lines are used exactly as written including their
+indentation:
+
+	<!--- gotomd::run::stdin=<<EOF ./cmd/tool --sort
+	banana
+	apple
+	EOF
+	-->
+
+A program exiting with any code other than `expect-exit` stops processing
+with an error showing its output.  When shown the exit code follows the
+output as it would in a shell:
+
+	<!--- gotomd::run::expect-exit=2 ./cmd/tool --bad-flag -->
+
+	unknown flag: --bad-flag
+	$ echo $?
+	2
+
+The `output` option selects how the program's standard output and standard
+error are displayed:
+
+   | output     | displays                                                   |
+   | ---------- | ---------------------------------------------------------- |
+   | `combined` | both streams merged as written                             |
+   | `stdout`   | only the standard output                                   |
+   | `stderr`   | only the standard error                                    |
+   | `both`     | each stream in its own block labelled `stdout:`/`stderr:`  |
+   | `marked`   | both streams merged with stderr lines prefixed `[stderr] ` |
+
+	<!--- gotomd::run::expect-exit=2 output=stderr ./cmd/tool --bad-flag -->
+
+The command displayed reproduces the options (`cd dir && KEY=value go run
+... < file`) so that it may still be copied and run exactly as shown.
+
+The `head`, `tail`, `lines` and `grep` options keep long output readable.
+The output is first limited to the `lines` range (`5-30`, `5-` or just `5`)
+then to the lines matching the `grep` regular expression before keeping the
+first `head` and last `tail` lines (both may be given).  Each place lines
+were removed is marked with `...`:
+
+	<!--- gotomd::run::head=3 tail=2 ./cmd/tool --list -->
+
+	alpha
+	beta
+	gamma
+	...
+	omega
+
+Each package is built only once (into a temporary directory removed when
+gotomd finishes) no matter how many `run` and `irun` directives use it.  The
+binary is then executed directly so compiler errors stop processing with an
+error rather than appearing as program output.  The `as` option displays the
+command as the installed program would be invoked:
+
+	<!--- gotomd::run::as=mytool ./cmd/mytool --help -->
+
+	mytool --help
+
+### Action: session
+
+Runs a sequence of commands (steps) in a new scratch directory (removed
+afterwards) and renders a single transcript of the commands and their output
+as they would appear in a terminal.  Each step sees the files left by the
+steps before it so tutorials may show stateful, multi-command walkthroughs.
+
+	<!--- gotomd::session::[option=value ...]
+	$ [option=value ...] program [argument ...]
+	! [option=value ...] program [argument ...]
+	-->
+
+Steps starting with `$ ` are shown with a prompt followed by their output.
+Steps starting with `! ` are hidden setup steps: they are run (and must
+succeed) but appear nowhere in the transcript.  Any other lines continue the
+step above them.  Each step accepts the same options as `run` with `cwd` and
+`stdin` files resolved within the scratch directory.
+
+The program is either a relative go package (built once and run directly,
+displayed by its binary name unless `as` is given), the built in `write`
+command saving its standard input to a file in the scratch directory
+(displayed as `cat > file`) or any other program allowed by the `exec`
+allow-list.
+
+The following options may appear on the directive's first line:
+
+   | option    | description                                               |
+   | --------- | --------------------------------------------------------- |
+   | `copy`    | `src[=dest]` fixture file or directory copied beforehand  |
+   | `timeout` | default maximum run time of each step (see Timeouts)      |
+
+The `copy` option may be repeated.  Sources are relative to the template's
+directory while destinations (defaulting to the source's name) are relative
+to the scratch directory.
+
+	<!--- gotomd::session::copy=./testdata/notes.txt
+	! ./cmd/notes init
+	$ ./cmd/notes list
+	$ stdin=<<EOF write more.txt
+	water plants
+	EOF
+	$ ./cmd/notes import more.txt
+	$ ./cmd/notes list
+	-->
+
 ### Action: snip
 
 Loads the referenced snippet and expands any embedded directives.
@@ -231,6 +643,29 @@ Inserts the contents of the specified Go source file, formatted as Go code.
 
 	<!--- gotomd::src::./directory/fileName.go -->
 
+Only part of the file is included when the file name is followed by either a
+line range (`#L10-L42` or just `#L10`) or the name of a region.
+
+	<!--- gotomd::src::./directory/fileName.go#L10-L42 -->
+
+Regions are delimited by comments containing the markers
+`gotomd:region name` and `gotomd:endregion`.  Regions may be nested.
+
+	// gotomd:region setup
+	func setup() {
+	    ...
+	}
+	// gotomd:endregion
+
+	<!--- gotomd::src::./directory/fileName.go#setup strip=true dedent=true -->
+
+The following options are supported:
+
+   | option   | default | description                                 |
+   | -------- | ------- | ------------------------------------------- |
+   | `strip`  | `false` | remove any region marker lines included     |
+   | `dedent` | `false` | remove the indentation common to all lines  |
+
 ### Action: tst
 
 Runs the specified Go test.
@@ -241,6 +676,31 @@ A `.` represents all tests.
 
 	<!--- gotomd::tst::./directory/. -->
 
+A `timeout=` option (see Timeouts) is supported by both `tst` and `tstc`:
+
+	<!--- gotomd::tst::./directory/. timeout=2m -->
+
+The `head`, `tail`, `lines` and `grep` options described for `run` are also
+supported by both `tst` and `tstc` (with no spaces in the `grep` expression):
+
+	<!--- gotomd::tst::./directory/. grep=^(---|ok|FAIL) -->
+
+Instead of the verbose text the `report` option runs `go test -json` and
+renders structured results.  Its value is a comma separated list of the
+renderings wanted (in order):
+
+   | report    | renders                                                       |
+   | --------- | ------------------------------------------------------------- |
+   | `table`   | a table of each test with its status and subtest counts       |
+   | `details` | a collapsed `<details>` block with the output of each failure |
+   | `summary` | the overall status and the tests passed, failed and skipped   |
+
+	<!--- gotomd::tst::./directory/. report=summary,table,details -->
+
+Tests are listed in the order they started and each test's output is kept
+together so the report does not depend on timing or on how parallel tests
+interleave.  A package that fails to build is reported as an error.
+
 ### Action: tstc
 
 Runs the specified Go test.
@@ -254,6 +714,84 @@ displayed on the GitHub website.
 
 	<!--- gotomd::tstc::./directory/. -->
 
+## Timeouts
+
+Every command run (`run`, `irun`, `exec`, `session`, `tst` and `tstc`) and
+every package
+load is limited to the time given by the `--timeout` argument (default `10m`)
+which the command directives may override with a `timeout=` option written
+either as a Go duration (`90s`, `2m30s`) or a number of seconds.  When the
+limit is reached the command along with every process it started is killed
+and processing stops with an error naming the directive and including any
+output collected so far.  Interrupting gotomd (Ctrl-C) likewise stops all
+running commands.
+
+## Normalization
+
+The output of every command run (`run`, `irun`, `exec`, `session`, `tst` and
+`tstc`) is normalized before being embedded so that nondeterministic noise
+does not cause spurious differences.  The following built in rules are
+applied:
+
+   | noise                                          | replaced with     |
+   | ---------------------------------------------- | ----------------- |
+   | timestamps (`2024-03-01T12:34:56Z`)            | `<timestamp>`     |
+   | fractional durations (`1.234s`, `12.5ms`)      | `0.0s`, `0.0ms`   |
+   | process ids (`pid 1234`, `PID: 1234`)          | `pid <pid>`       |
+   | random temporary paths (`/tmp/go-build123456`) | `$TMPDIR`         |
+   | the user's home directory                      | `~`               |
+   | the host name (whole words, not `localhost`)   | `<hostname>`      |
+
+Additional rules (a regular expression applied to each line and its
+replacement which may refer to submatches as `${1}`) are applied after the
+built in ones which may be disabled.  Both are configured in the
+`.gotomd.json` configuration file:
+
+	{
+	  "normalize": {
+	    "builtin": true,
+	    "rules": [
+	      {"match": "v(\\d+)\\.\\d+\\.\\d+", "replace": "v${1}.x.y"},
+	      {"match": "token=\\S+", "replace": "token=REDACTED"}
+	    ]
+	  }
+	}
+
+## Workspaces
+
+When the template directory belongs to a `go.work` workspace, directive
+directories may also be written relative to the template using `../` as long
+as they remain within the workspace root or one of the modules it uses
+(including modules outside the root such as `use ../sibling`):
+
+	<!--- gotomd::doc::../otherModule/pkg/goObject -->
+
+Packages are always loaded, run and tested from the module that owns them
+with the workspace file explicitly selected, so path resolution does not
+depend on the template's directory.  A `./...` search starting in a directory
+containing a `go.work` file also follows every module it uses, including
+modules located outside that directory.
+
+# Exit Status
+
+The program exits with `0` on success, `1` if processing fails and `2` if
+`--uptodate` finds documentation that would be changed.
+
+------------------------------------------------------------------------------
+	go run . --permission 0644 --uptodate
+
+	Failed: uptodate incompatible with perm
+	$ echo $?
+	1
+------------------------------------------------------------------------------
+
+------------------------------------------------------------------------------
+	go run . --uptodate ./internal/testdata/stale
+
+	$ echo $?
+	2
+------------------------------------------------------------------------------
+
 # Dedication
 
 	***************************************************************************
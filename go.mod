@@ -10,11 +10,11 @@ require (
 	github.com/dancsecs/szlog v0.0.15
 	github.com/dancsecs/sztestlog v0.0.15
 	github.com/hexops/gotextdiff v1.0.3
+	golang.org/x/mod v0.35.0
 	golang.org/x/tools v0.44.0
 )
 
 require (
-	golang.org/x/mod v0.35.0 // indirect
 	golang.org/x/sync v0.20.0 // indirect
 	golang.org/x/sys v0.43.0 // indirect
 	golang.org/x/term v0.42.0 // indirect
@@ -20,11 +20,51 @@ package args
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/szlog"
 )
 
+// addWorkspace includes all modules used by a go.work file located directly
+// in the directory that are not already contained within it.
+func addWorkspace(dir string) error {
+	var (
+		ws      *gowork.Workspace
+		absDir  string
+		relDir  string
+		rel     string
+		modDirs []string
+		err     error
+	)
+
+	if !gowork.IsWorkspaceRoot(dir) {
+		return nil
+	}
+
+	ws, err = gowork.Find(dir)
+	if err == nil && ws != nil {
+		modDirs = ws.Modules()
+		absDir, err = filepath.Abs(dir)
+	}
+
+	for i, mi := 0, len(modDirs); i < mi && err == nil; i++ {
+		rel, err = filepath.Rel(absDir, modDirs[i])
+		if err == nil && !filepath.IsLocal(rel) {
+			relDir, err = gowork.Relative(modDirs[i])
+			if err == nil {
+				szlog.Say1("Following workspace module: '", relDir, "'\n")
+
+				err = add(relDir, true)
+			}
+		}
+	}
+
+	return err
+}
+
 // Expand scans the list replacing entries like "{dir}/..." with a
 // recursive list of {dir} and all of its subdirectories.
 func expand(list []string) error {
@@ -44,6 +84,10 @@ func expand(list []string) error {
 		}
 
 		err = add(entry, recursive)
+
+		if err == nil && recursive {
+			err = addWorkspace(entry)
+		}
 	}
 
 	if err == nil {
@@ -193,3 +193,42 @@ func TestFiles_Expand(t *testing.T) {
 		"File to process: '"+fileGoodMD+"'",
 	)
 }
+
+func TestFiles_Expand_Workspace(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	Reset()
+
+	chk.DelEnv("GOWORK")
+
+	_ = chk.CreateTmpDir()
+	wsDir := chk.CreateTmpSubDir("ws")
+	modDir := chk.CreateTmpSubDir("ws", "mod")
+	outDir := chk.CreateTmpSubDir("outside")
+
+	_ = chk.CreateTmpFileAs(wsDir, "go.work", []byte(
+		"go 1.25\n\nuse (\n\t./mod\n\t../outside\n)\n",
+	))
+	_ = chk.CreateTmpFileAs(modDir, ".1"+MdTemplate, nil)
+	_ = chk.CreateTmpFileAs(outDir, ".2"+MdTemplate, nil)
+
+	t.Chdir(wsDir)
+
+	chk.NoErr(expand([]string{"./..."}))
+
+	chk.StrSlice(
+		MdFiles(),
+		[]string{
+			filepath.Join("mod", ".1"+MdTemplate),
+			filepath.Join("..", "outside", ".2"+MdTemplate),
+		},
+	)
+
+	chk.Stdout(
+		"File to process: '"+filepath.Join("mod", ".1"+MdTemplate)+"'",
+		"Following workspace module: '"+filepath.Join("..", "outside")+"'",
+		"File to process: '"+
+			filepath.Join("..", "outside", ".2"+MdTemplate)+"'",
+	)
+}
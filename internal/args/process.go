@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/dancsecs/gotomd/internal/cmds"
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/szargs"
 	"github.com/dancsecs/szlog"
@@ -39,6 +40,9 @@ func Process() error {
 		foundEgg    bool
 		foundOutput bool
 		foundPerm   bool
+		rawTimeout  string
+		foundTime   bool
+		validTime   bool
 		err         error
 	)
 
@@ -70,6 +74,11 @@ func Process() error {
 		permDesc,
 	)
 
+	buildTags, _ = args.ValueString(tagsFlag, tagsDesc)
+	buildGOOS, _ = args.ValueString(goosFlag, goosDesc)
+	buildGOARCH, _ = args.ValueString(goarchFlag, goarchDesc)
+	rawTimeout, foundTime = args.ValueString(timeoutFlag, timeoutDesc)
+
 	args.RegisterUsage(pathArg, pathDesc)
 
 	if !foundOutput {
@@ -82,6 +91,15 @@ func Process() error {
 		perm = os.FileMode(permInt)
 	}
 
+	if foundTime {
+		timeout, validTime = cmds.ParseDuration(rawTimeout)
+		if !validTime {
+			args.PushErr(
+				fmt.Errorf("%w: '%s'", errs.ErrInvalidTimeout, rawTimeout),
+			)
+		}
+	}
+
 	if int(permInt)&(^0o0666) != 0 {
 		args.PushErr(
 			fmt.Errorf("%w: '0o%#o'", errs.ErrInvalidDefPerm, permInt),
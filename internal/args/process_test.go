@@ -20,9 +20,11 @@ package args_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/dancsecs/gotomd/internal/args"
 	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/proc"
 	"github.com/dancsecs/sztestlog"
 )
 
@@ -203,3 +205,75 @@ func TestArgUsage_Dedication(t *testing.T) {
 ***************************************************************************
 `)
 }
+
+func Test_ArgUsage_BuildConstraints(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetArgs(
+		"programName",
+		"--tags", "integration,extra",
+		"--goos", "windows",
+		"--goarch", "arm64",
+		".",
+	)
+
+	chk.NoErr(args.Process())
+
+	chk.Str(args.BuildTags(), "integration,extra")
+	chk.Str(args.BuildGOOS(), "windows")
+	chk.Str(args.BuildGOARCH(), "arm64")
+
+	chk.SetArgs(
+		"programName",
+		".",
+	)
+
+	chk.NoErr(args.Process())
+
+	chk.Str(args.BuildTags(), "")
+	chk.Str(args.BuildGOOS(), "")
+	chk.Str(args.BuildGOARCH(), "")
+}
+
+func Test_ArgUsage_Timeout(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.SetArgs(
+		"programName",
+		"--timeout", "90s",
+		".",
+	)
+
+	chk.NoErr(args.Process())
+	chk.Dur(args.Timeout(), 90*time.Second)
+
+	chk.SetArgs(
+		"programName",
+		"--timeout", "5",
+		".",
+	)
+
+	chk.NoErr(args.Process())
+	chk.Dur(args.Timeout(), 5*time.Second)
+
+	chk.SetArgs(
+		"programName",
+		".",
+	)
+
+	chk.NoErr(args.Process())
+	chk.Dur(args.Timeout(), proc.DefaultTimeout)
+
+	chk.SetArgs(
+		"programName",
+		"--timeout", "never",
+		".",
+	)
+
+	chk.Err(
+		args.Process(),
+		errs.ErrInvalidTimeout.Error()+": 'never'",
+	)
+}
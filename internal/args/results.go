@@ -18,7 +18,12 @@
 
 package args
 
-import "os"
+import (
+	"os"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/proc"
+)
 
 const defaultPerm = os.FileMode(0o0644)
 
@@ -34,6 +39,10 @@ var (
 	showLicense    bool
 	showHelp       bool
 	upToDate       bool
+	buildTags      string
+	buildGOOS      string
+	buildGOARCH    string
+	timeout        = proc.DefaultTimeout
 
 	alreadyIncluded = make(map[string]bool)
 )
@@ -50,6 +59,10 @@ func Reset() {
 	showLicense = false
 	showHelp = false
 	upToDate = false
+	buildTags = ""
+	buildGOOS = ""
+	buildGOARCH = ""
+	timeout = proc.DefaultTimeout
 
 	for k := range alreadyIncluded {
 		delete(alreadyIncluded, k)
@@ -106,3 +119,26 @@ func ShowHelp() bool {
 func CheckUpToDate() bool {
 	return upToDate
 }
+
+// BuildTags returns the default build tags used when loading packages.
+func BuildTags() string {
+	return buildTags
+}
+
+// BuildGOOS returns the default target operating system used when loading
+// packages.
+func BuildGOOS() string {
+	return buildGOOS
+}
+
+// BuildGOARCH returns the default target architecture used when loading
+// packages.
+func BuildGOARCH() string {
+	return buildGOARCH
+}
+
+// Timeout returns the default timeout for running commands and loading
+// packages.
+func Timeout() time.Duration {
+	return timeout
+}
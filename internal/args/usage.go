@@ -64,13 +64,39 @@ Returns 0 if no changes would have been made. No writes are performed.
 Permissions to use when creating new file.
 
 (can only set RW bits).
+`
+
+	tagsFlag = "[--tags <tags>]"
+	tagsDesc = `
+Comma separated build tags used when loading packages for documentation.
+Directives may override this with a 'tags=' option.
+`
+
+	goosFlag = "[--goos <os>]"
+	goosDesc = `
+Target operating system (GOOS) used when loading packages for
+documentation. Directives may override this with a 'goos=' option.
+`
+
+	goarchFlag = "[--goarch <arch>]"
+	goarchDesc = `
+Target architecture (GOARCH) used when loading packages for documentation.
+Directives may override this with a 'goarch=' option.
+`
+
+	timeoutFlag = "[--timeout <duration>]"
+	timeoutDesc = `
+Maximum time (such as '90s' or '5m') allowed for each command run or package
+load. Directives may override this with a 'timeout=' option. Defaults to
+'10m'.
 `
 
 	pathArg  = "[path ...]"
 	pathDesc = `
 Specific template files (named like '.*.gtm.md' or '.*.gtm.go') or
 a directory which will be searched for all matching template files.
-All subdirectories may be searched by using the special './...' path.
+All subdirectories may be searched by using the special './...' path
+which also follows all modules used by a go.work file in the directory.
 It defaults to search the current directory: '.'
 `
 )
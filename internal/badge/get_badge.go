@@ -0,0 +1,347 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package badge
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gocover"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/gotest"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/gotomd/internal/update"
+	"golang.org/x/mod/modfile"
+)
+
+// Options controlling the badge.
+const (
+	optFile       = "file"
+	optLabel      = "label"
+	optThresholds = "thresholds"
+	optTimeout    = "timeout"
+)
+
+// Kinds of badges.
+const (
+	kindCoverage = "coverage"
+	kindGo       = "go"
+	kindLicense  = "license"
+	kindTests    = "tests"
+)
+
+// Coverage below the first threshold is red, below the second yellow and
+// green otherwise.
+const defaultThresholds = "50,80"
+
+const maxPercent = 100
+
+type badgeOptions struct {
+	file       string
+	label      string
+	thresholds [2]int
+	timeout    time.Duration
+}
+
+func parseThresholds(value string) ([2]int, error) {
+	var (
+		limits [2]int
+		err    error
+	)
+
+	low, high, found := strings.Cut(value, ",")
+	if !found {
+		err = errs.ErrInvalidOption
+	}
+
+	if err == nil {
+		limits[0], err = strconv.Atoi(low)
+	}
+
+	if err == nil {
+		limits[1], err = strconv.Atoi(high)
+	}
+
+	if err == nil &&
+		(limits[0] < 0 || limits[0] > limits[1] || limits[1] > maxPercent) {
+		err = errs.ErrInvalidOption
+	}
+
+	if err == nil {
+		return limits, nil
+	}
+
+	return limits, fmt.Errorf(
+		"%w: %s=%q", errs.ErrInvalidOption, optThresholds, value,
+	)
+}
+
+func parseBadgeCmd(cmd string) (string, string, *badgeOptions, error) {
+	var (
+		kind     string
+		pkg      string
+		opts     cmds.Options
+		badgeOpt = new(badgeOptions)
+		err      error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(
+		cmd, optFile, optLabel, optThresholds, optTimeout,
+	)
+
+	if err == nil {
+		words := strings.Fields(cmd)
+		if len(words) > 0 {
+			kind = words[0]
+		}
+
+		if len(words) > 1 {
+			pkg = strings.Join(words[1:], " ")
+		}
+
+		switch kind {
+		case kindCoverage, kindTests:
+			if pkg == "" {
+				err = errs.ErrMissingAction
+			}
+		case kindGo, kindLicense:
+			if pkg != "" {
+				err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, pkg)
+			}
+		default:
+			err = fmt.Errorf("%w: %q", errs.ErrUnknownBadge, kind)
+		}
+	}
+
+	if err == nil {
+		badgeOpt.file = filepath.Clean(
+			opts.Value(optFile, "badge-"+kind+".svg"),
+		)
+		if !filepath.IsLocal(badgeOpt.file) {
+			err = fmt.Errorf("%w: %q", errs.ErrNotLocalDir, badgeOpt.file)
+		}
+	}
+
+	if err == nil {
+		badgeOpt.label = opts.Value(optLabel, kind)
+		badgeOpt.thresholds, err = parseThresholds(
+			opts.Value(optThresholds, defaultThresholds),
+		)
+	}
+
+	if err == nil {
+		badgeOpt.timeout, err = opts.Duration(optTimeout, proc.Timeout())
+	}
+
+	if err == nil {
+		return kind, pkg, badgeOpt, nil
+	}
+
+	return "", "", nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// packageDir splits the package ("./dir/." or "./dir/...") into its
+// directory and action.
+func packageDir(pkg string) (string, string, error) {
+	dir, action, err := cmds.ParseCmd(pkg)
+
+	if err == nil && action != "." && action != "..." {
+		err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, action)
+	}
+
+	return dir, action, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+func coverageBadge(pkg string, opts *badgeOptions) (string, string, error) {
+	var (
+		dir     string
+		action  string
+		percent float64
+		color   = colorGreen
+		err     error
+	)
+
+	dir, action, err = packageDir(pkg)
+
+	if err == nil {
+		percent, err = gocover.Total(
+			dir, action, gopkg.BuildFromOptions(nil), opts.timeout,
+		)
+	}
+
+	if err == nil {
+		switch {
+		case percent < float64(opts.thresholds[0]):
+			color = colorRed
+		case percent < float64(opts.thresholds[1]):
+			color = colorYellow
+		}
+
+		return strconv.FormatFloat(percent, 'f', 1, 64) + "%", color, nil
+	}
+
+	return "", "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+func testsBadge(pkg string, opts *badgeOptions) (string, string, error) {
+	var (
+		dir     string
+		action  string
+		passed  int
+		failed  int
+		skipped int
+		err     error
+	)
+
+	dir, action, err = packageDir(pkg)
+
+	if err == nil {
+		passed, failed, skipped, err = gotest.Count(dir, action, opts.timeout)
+	}
+
+	switch {
+	case err != nil:
+		return "", "", err //nolint:wrapcheck // Caller will wrap error.
+	case failed > 0:
+		return fmt.Sprintf("%d failed", failed), colorRed, nil
+	case skipped > 0:
+		return fmt.Sprintf("%d passed, %d skipped", passed, skipped),
+			colorGreen, nil
+	default:
+		return fmt.Sprintf("%d passed", passed), colorGreen, nil
+	}
+}
+
+func moduleDir() (string, error) {
+	modDir, err := gowork.ModuleRoot(".")
+	if err == nil && modDir == "" {
+		err = errs.ErrNoModule
+	}
+
+	return modDir, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+func goBadge() (string, string, error) {
+	var (
+		modDir string
+		data   []byte
+		mod    *modfile.File
+		err    error
+	)
+
+	modDir, err = moduleDir()
+
+	if err == nil {
+		fName := filepath.Join(modDir, "go.mod")
+
+		data, err = os.ReadFile(fName) //nolint:gosec // Ok.
+		if err == nil {
+			mod, err = modfile.ParseLax(fName, data, nil)
+		}
+	}
+
+	switch {
+	case err != nil:
+		return "", "", err //nolint:wrapcheck // Caller will wrap error.
+	case mod.Go == nil:
+		return valueUnknown, colorGrey, nil
+	default:
+		return mod.Go.Version, colorBlue, nil
+	}
+}
+
+func licenseBadge() (string, string, error) {
+	modDir, err := moduleDir()
+
+	var license string
+
+	if err == nil {
+		license, err = detectLicense(modDir)
+	}
+
+	switch {
+	case err != nil:
+		return "", "", err
+	case license == licenseNone || license == valueUnknown:
+		return license, colorGrey, nil
+	default:
+		return license, colorBlue, nil
+	}
+}
+
+// GetBadge computes a badge ("coverage ./dir/...", "tests ./dir/...", "go"
+// or "license") locally, writes it as an SVG file next to the document and
+// returns a relative image link to it.  The file is only rewritten when it
+// has changed.
+func GetBadge(cmd string) (string, error) {
+	var (
+		kind  string
+		pkg   string
+		opts  *badgeOptions
+		value string
+		color string
+		err   error
+	)
+
+	kind, pkg, opts, err = parseBadgeCmd(cmd)
+
+	if err == nil {
+		switch kind {
+		case kindCoverage:
+			value, color, err = coverageBadge(pkg, opts)
+		case kindTests:
+			value, color, err = testsBadge(pkg, opts)
+		case kindGo:
+			value, color, err = goBadge()
+		default:
+			value, color, err = licenseBadge()
+		}
+	}
+
+	if err == nil {
+		_, err = update.File(
+			opts.file,
+			args.Force(),
+			args.CheckUpToDate(),
+			svg(opts.label, value, color),
+			args.Perm(),
+		)
+	}
+
+	if err == nil {
+		if format.IsForMarkdown() {
+			return fmt.Sprintf("![%s: %s](%s)",
+				opts.label, value, filepath.ToSlash(opts.file),
+			), nil
+		}
+
+		return opts.label + ": " + value, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
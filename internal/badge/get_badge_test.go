@@ -0,0 +1,119 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package badge_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/badge"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/gotomd/internal/update"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupModule(t *testing.T, chk *sztest.Chk, procArgs ...string) string {
+	t.Helper()
+
+	dir := testmod.Setup(t, chk, "app")
+	testmod.Args(chk, procArgs...)
+
+	update.ResetUpToDate()
+
+	return dir
+}
+
+func readBadge(chk *sztest.Chk, fName string) string {
+	data, err := os.ReadFile(fName) //nolint:gosec // Ok.
+	chk.NoErr(err)
+
+	return string(data)
+}
+
+func Test_GetBadge_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	_, err := badge.GetBadge("stars")
+	chk.Err(err, errs.ErrUnknownBadge.Error()+`: "stars"`)
+
+	_, err = badge.GetBadge("coverage")
+	chk.Err(err, errs.ErrMissingAction.Error())
+
+	_, err = badge.GetBadge("go ./...")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "./..."`)
+
+	_, err = badge.GetBadge("coverage ./Sign")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "Sign"`)
+
+	_, err = badge.GetBadge("go file=../go.svg")
+	chk.Err(err, errs.ErrNotLocalDir.Error()+`: "../go.svg"`)
+
+	_, err = badge.GetBadge("coverage ./... thresholds=80,50")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: thresholds="80,50"`)
+
+	_, err = badge.GetBadge("coverage ./... thresholds=80")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: thresholds="80"`)
+}
+
+func Test_GetBadge(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk, "-f")
+
+	res, err := badge.GetBadge("coverage ./...")
+	chk.NoErr(err)
+	chk.Str(res, "![coverage: 66.7%](badge-coverage.svg)")
+	chk.True(strings.Contains(
+		readBadge(chk, "badge-coverage.svg"), `fill="#dfb317"`,
+	))
+
+	res, err = badge.GetBadge("coverage ./. thresholds=70,90 label=cov")
+	chk.NoErr(err)
+	chk.Str(res, "![cov: 66.7%](badge-coverage.svg)")
+	chk.True(strings.Contains(
+		readBadge(chk, "badge-coverage.svg"), `fill="#e05d44"`,
+	))
+
+	_ = chk.CreateTmpSubDir("badges")
+
+	res, err = badge.GetBadge("tests ./... file=badges/tests.svg")
+	chk.NoErr(err)
+	chk.Str(res, "![tests: 2 passed, 1 skipped](badges/tests.svg)")
+	chk.True(strings.Contains(
+		readBadge(chk, "badges/tests.svg"), `fill="#4c1"`,
+	))
+
+	res, err = badge.GetBadge("go")
+	chk.NoErr(err)
+	chk.Str(res, "![go: 1.25](badge-go.svg)")
+
+	res, err = badge.GetBadge("license")
+	chk.NoErr(err)
+	chk.Str(res, "![license: MIT](badge-license.svg)")
+	chk.True(strings.Contains(
+		readBadge(chk, "badge-license.svg"), "<title>license: MIT</title>",
+	))
+}
@@ -0,0 +1,161 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package badge
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Names of the files searched (in order) for the module's license.
+//
+//nolint:goCheckNoGlobals // Ok.
+var licenseFiles = []string{
+	"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING", "COPYING.md",
+}
+
+// licensePattern identifies a license by phrases (all of which must be
+// present) found in its text.
+type licensePattern struct {
+	id      string
+	phrases []string
+}
+
+// Number of non-blank lines at the start of a license searched for its title.
+const licenseTitleLines = 2
+
+// Licenses identified by the title at the start of their text.  Only the
+// title is checked as the body of one license may name another (the GPL
+// text refers to both the AGPL and the LGPL).
+//
+//nolint:goCheckNoGlobals // Ok.
+var licenseTitles = []licensePattern{
+	{"AGPL-3.0", []string{"GNU AFFERO GENERAL PUBLIC LICENSE", "VERSION 3"}},
+	{"LGPL-3.0", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "VERSION 3"}},
+	{"LGPL-2.1", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "VERSION 2.1"}},
+	{"GPL-3.0", []string{"GNU GENERAL PUBLIC LICENSE", "VERSION 3"}},
+	{"GPL-2.0", []string{"GNU GENERAL PUBLIC LICENSE", "VERSION 2"}},
+	{"Apache-2.0", []string{"APACHE LICENSE", "VERSION 2.0"}},
+	{"MPL-2.0", []string{"MOZILLA PUBLIC LICENSE VERSION 2.0"}},
+	{"MIT", []string{"MIT LICENSE"}},
+}
+
+// Untitled licenses identified by phrases in their body, checked in order
+// so more specific variants come first.
+//
+//nolint:goCheckNoGlobals // Ok.
+var licenseTexts = []licensePattern{
+	{"MIT", []string{"PERMISSION IS HEREBY GRANTED, FREE OF CHARGE"}},
+	{"BSD-3-Clause", []string{
+		"REDISTRIBUTION AND USE IN SOURCE AND BINARY FORMS",
+		"NEITHER THE NAME",
+	}},
+	{"BSD-2-Clause", []string{
+		"REDISTRIBUTION AND USE IN SOURCE AND BINARY FORMS",
+	}},
+	{"ISC", []string{"PERMISSION TO USE, COPY, MODIFY, AND/OR DISTRIBUTE"}},
+	{"Unlicense", []string{"THIS IS FREE AND UNENCUMBERED SOFTWARE"}},
+}
+
+// Values reported when no license could be identified.
+const (
+	licenseNone  = "none"
+	valueUnknown = "unknown"
+)
+
+// matchLicense returns the identifier of the first pattern whose phrases
+// are all found in the text.
+func matchLicense(text string, patterns []licensePattern) (string, bool) {
+	for _, pattern := range patterns {
+		matched := true
+
+		for _, phrase := range pattern.phrases {
+			matched = matched && strings.Contains(text, phrase)
+		}
+
+		if matched {
+			return pattern.id, true
+		}
+	}
+
+	return "", false
+}
+
+// licenseTitle returns the first non-blank lines of the license text.
+func licenseTitle(text string) string {
+	var title []string
+
+	for line := range strings.Lines(text) {
+		if strings.TrimSpace(line) != "" {
+			title = append(title, line)
+		}
+
+		if len(title) == licenseTitleLines {
+			break
+		}
+	}
+
+	return strings.Join(title, "")
+}
+
+// normalizeLicense upper cases the text collapsing all white space so
+// case and line wrapping are ignored.
+func normalizeLicense(text string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(text)), " ")
+}
+
+// identifyLicense returns the SPDX identifier of the license text or
+// "unknown".  Titled licenses are identified by their first non-blank
+// lines and untitled ones by their body.
+func identifyLicense(text string) string {
+	id, found := matchLicense(
+		normalizeLicense(licenseTitle(text)), licenseTitles,
+	)
+
+	if !found {
+		id, found = matchLicense(normalizeLicense(text), licenseTexts)
+	}
+
+	if found {
+		return id
+	}
+
+	return valueUnknown
+}
+
+// detectLicense identifies the license found in the module directory
+// returning "none" if no license file is present.
+func detectLicense(modDir string) (string, error) {
+	for _, name := range licenseFiles {
+		data, err := os.ReadFile( //nolint:gosec // Ok.
+			filepath.Join(modDir, name),
+		)
+
+		switch {
+		case err == nil:
+			return identifyLicense(string(data)), nil
+		case !errors.Is(err, os.ErrNotExist):
+			return "", err //nolint:wrapcheck // Caller will wrap error.
+		}
+	}
+
+	return licenseNone, nil
+}
@@ -0,0 +1,106 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package badge
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_License_Identify(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	for _, tc := range []struct {
+		text string
+		want string
+	}{
+		/* Idx:  0 */ {
+			"GNU GENERAL PUBLIC LICENSE\n   Version 3, 29 June 2007",
+			"GPL-3.0",
+		},
+		/* Idx:  1 */ {
+			"GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3", "LGPL-3.0",
+		},
+		/* Idx:  2 */ {
+			"Apache License\n  Version 2.0, January 2004", "Apache-2.0",
+		},
+		/* Idx:  3 */ {
+			"Permission is hereby granted,\nfree of charge, to", "MIT",
+		},
+		/* Idx:  4 */ {
+			"Redistribution and use in source and binary forms ...\n" +
+				"Neither the name of", "BSD-3-Clause",
+		},
+		/* Idx:  5 */ {
+			"Redistribution and use in source and binary forms",
+			"BSD-2-Clause",
+		},
+		/* Idx:  6 */ {
+			"All rights reserved.", valueUnknown,
+		},
+		/* Idx:  7 */ {
+			"\n  GNU GENERAL PUBLIC LICENSE\n\n  Version 3, 29 June 2007\n" +
+				"\nUse with the GNU Affero General Public License.\n" +
+				"Version 3 of the GNU Lesser General Public License...",
+			"GPL-3.0",
+		},
+		/* Idx:  8 */ {
+			"Copyright (c)\nAll rights reserved.\n" +
+				"GNU AFFERO GENERAL PUBLIC LICENSE Version 3",
+			valueUnknown,
+		},
+		/* Idx:  9 */ {
+			"MIT License\n\nCopyright (c) 2026 Someone", "MIT",
+		},
+	} {
+		chk.Str(identifyLicense(tc.text), tc.want)
+	}
+}
+
+func Test_License_Detect(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	dir := chk.CreateTmpDir()
+
+	license, err := detectLicense(dir)
+	chk.NoErr(err)
+	chk.Str(license, licenseNone)
+
+	_ = chk.CreateTmpFileAs(dir, "COPYING", []byte(
+		"This is free and unencumbered software released into the public\n"+
+			"domain.\n",
+	))
+
+	license, err = detectLicense(dir)
+	chk.NoErr(err)
+	chk.Str(license, "Unlicense")
+}
+
+func Test_License_DetectRepository(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	license, err := detectLicense(filepath.Join("..", ".."))
+	chk.NoErr(err)
+	chk.Str(license, "GPL-3.0")
+}
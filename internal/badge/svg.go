@@ -0,0 +1,108 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package badge
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// Badge colors.
+const (
+	colorGreen  = "#4c1"
+	colorYellow = "#dfb317"
+	colorRed    = "#e05d44"
+	colorBlue   = "#007ec6"
+	colorGrey   = "#9f9f9f"
+	colorLabel  = "#555"
+)
+
+// Approximate widths (in pixels) of 11px Verdana characters.
+const (
+	narrowWidth = 4
+	normalWidth = 7
+	wideWidth   = 10
+	padding     = 10
+)
+
+// textWidth estimates the rendered width of the text.  Badges only need to
+// be close enough for the text to fit with some padding.
+func textWidth(text string) int {
+	width := 0
+
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune(" .,:;!|'()[]ijlft1", r):
+			width += narrowWidth
+		case strings.ContainsRune("%mwMW@", r):
+			width += wideWidth
+		default:
+			width += normalWidth
+		}
+	}
+
+	return width + padding
+}
+
+// svg renders a flat badge with the label on the left and the value on the
+// right in the supplied color.
+func svg(label, value, color string) string {
+	var (
+		labelWidth = textWidth(label)
+		valueWidth = textWidth(value)
+		width      = labelWidth + valueWidth
+		title      = html.EscapeString(label + ": " + value)
+		labelText  = html.EscapeString(label)
+		valueText  = html.EscapeString(value)
+	)
+
+	return fmt.Sprintf(""+
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20"`+
+		` role="img" aria-label="%[2]s">`+"\n"+
+		`  <title>%[2]s</title>`+"\n"+
+		`  <linearGradient id="s" x2="0" y2="100%%">`+"\n"+
+		`    <stop offset="0" stop-color="#bbb" stop-opacity=".1"/>`+"\n"+
+		`    <stop offset="1" stop-opacity=".1"/>`+"\n"+
+		`  </linearGradient>`+"\n"+
+		`  <clipPath id="r">`+"\n"+
+		`    <rect width="%[1]d" height="20" rx="3" fill="#fff"/>`+"\n"+
+		`  </clipPath>`+"\n"+
+		`  <g clip-path="url(#r)">`+"\n"+
+		`    <rect width="%[3]d" height="20" fill="%[4]s"/>`+"\n"+
+		`    <rect x="%[3]d" width="%[5]d" height="20" fill="%[6]s"/>`+"\n"+
+		`    <rect width="%[1]d" height="20" fill="url(#s)"/>`+"\n"+
+		`  </g>`+"\n"+
+		`  <g fill="#fff" text-anchor="middle"`+
+		` font-family="Verdana,Geneva,DejaVu Sans,sans-serif"`+
+		` font-size="11">`+"\n"+
+		`    <text x="%[7]d" y="15" fill="#010101" fill-opacity=".3">`+
+		`%[8]s</text>`+"\n"+
+		`    <text x="%[7]d" y="14">%[8]s</text>`+"\n"+
+		`    <text x="%[9]d" y="15" fill="#010101" fill-opacity=".3">`+
+		`%[10]s</text>`+"\n"+
+		`    <text x="%[9]d" y="14">%[10]s</text>`+"\n"+
+		`  </g>`+"\n"+
+		`</svg>`+"\n",
+		width, title,
+		labelWidth, colorLabel, valueWidth, color,
+		labelWidth/2, labelText, //nolint:mnd // Center of the label.
+		labelWidth+valueWidth/2, valueText, //nolint:mnd // Center of value.
+	)
+}
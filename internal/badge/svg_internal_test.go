@@ -0,0 +1,69 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package badge
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Svg_TextWidth(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Int(textWidth(""), padding)
+	chk.Int(textWidth("go"), 24)
+	chk.Int(textWidth("1.25"), 32)
+	chk.Int(textWidth("66.7%"), 45)
+}
+
+func Test_Svg_Render(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(svg("go", "<1.25>", colorBlue), ""+
+		`<svg xmlns="http://www.w3.org/2000/svg" width="70" height="20"`+
+		` role="img" aria-label="go: &lt;1.25&gt;">`+"\n"+
+		`  <title>go: &lt;1.25&gt;</title>`+"\n"+
+		`  <linearGradient id="s" x2="0" y2="100%">`+"\n"+
+		`    <stop offset="0" stop-color="#bbb" stop-opacity=".1"/>`+"\n"+
+		`    <stop offset="1" stop-opacity=".1"/>`+"\n"+
+		`  </linearGradient>`+"\n"+
+		`  <clipPath id="r">`+"\n"+
+		`    <rect width="70" height="20" rx="3" fill="#fff"/>`+"\n"+
+		`  </clipPath>`+"\n"+
+		`  <g clip-path="url(#r)">`+"\n"+
+		`    <rect width="24" height="20" fill="#555"/>`+"\n"+
+		`    <rect x="24" width="46" height="20" fill="#007ec6"/>`+"\n"+
+		`    <rect width="70" height="20" fill="url(#s)"/>`+"\n"+
+		`  </g>`+"\n"+
+		`  <g fill="#fff" text-anchor="middle"`+
+		` font-family="Verdana,Geneva,DejaVu Sans,sans-serif"`+
+		` font-size="11">`+"\n"+
+		`    <text x="12" y="15" fill="#010101" fill-opacity=".3">`+
+		`go</text>`+"\n"+
+		`    <text x="12" y="14">go</text>`+"\n"+
+		`    <text x="47" y="15" fill="#010101" fill-opacity=".3">`+
+		`&lt;1.25&gt;</text>`+"\n"+
+		`    <text x="47" y="14">&lt;1.25&gt;</text>`+"\n"+
+		`  </g>`+"\n"+
+		`</svg>`+"\n",
+	)
+}
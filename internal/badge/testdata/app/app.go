@@ -0,0 +1,9 @@
+package app
+
+func Sign(n int) int {
+	if n < 0 {
+		return -1
+	}
+
+	return 1
+}
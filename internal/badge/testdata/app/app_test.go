@@ -0,0 +1,15 @@
+package app
+
+import "testing"
+
+func TestSign(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		if Sign(1) != 1 {
+			t.Fail()
+		}
+	})
+}
+
+func TestSkip(t *testing.T) {
+	t.Skip()
+}
@@ -26,12 +26,43 @@ import (
 	"strings"
 
 	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gowork"
 )
 
-// ParseCmd parses and verifies a single command.
+// checkRelative verifies the command begins with a relative directory.  A
+// directory outside the current directory ("../") is only permitted if it
+// remains within the go.work workspace governing the current directory or
+// one of the modules it uses.
+func checkRelative(cmd string) error {
+	if strings.HasPrefix(cmd, "./") {
+		return nil
+	}
+
+	if strings.HasPrefix(cmd, "../") {
+		dir := cmd[:strings.LastIndex(cmd, string(os.PathSeparator))]
+
+		ws, err := gowork.Find(".")
+		if err != nil {
+			return err //nolint:wrapcheck // Already wrapped.
+		}
+
+		if ws != nil && ws.Contains(dir) {
+			return nil
+		}
+
+		return fmt.Errorf("%w: %q", errs.ErrOutsideWorkspace, cmd)
+	}
+
+	return fmt.Errorf("%w: %q", errs.ErrInvalidRelativeDir, cmd)
+}
+
+// ParseCmd parses and verifies a single command.  Directories must be
+// relative to the current directory or, when part of a go.work workspace,
+// may reference any directory within the workspace.
 func ParseCmd(cmd string) (string, string, error) {
-	if !strings.HasPrefix(cmd, "./") {
-		return "", "", fmt.Errorf("%w: %q", errs.ErrInvalidRelativeDir, cmd)
+	err := checkRelative(cmd)
+	if err != nil {
+		return "", "", err
 	}
 
 	lastSeparatorPos := strings.LastIndex(cmd, string(os.PathSeparator))
@@ -71,7 +102,10 @@ func ParseCmds(cmdStr string) ([]string, []string, error) {
 		cmd := cmds[i]
 		if lastDir != "" &&
 			strings.LastIndex(cmd, string(os.PathSeparator)) < 0 {
-			cmd = "." + string(os.PathSeparator) + filepath.Join(lastDir, cmd)
+			cmd = filepath.Join(lastDir, cmd)
+			if !strings.HasPrefix(cmd, "..") {
+				cmd = "." + string(os.PathSeparator) + cmd
+			}
 		}
 
 		dir, action, err = ParseCmd(cmd)
@@ -211,3 +211,88 @@ func Test_CmdParse_ParseCmds2(t *testing.T) {
 	chk.StrSlice(dirs, []string{example1Path, example2Path})
 	chk.StrSlice(actions, []string{"action", "action2"})
 }
+
+func Test_CmdParse_ParseCmd_Workspace(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.DelEnv("GOWORK")
+
+	tstDir := chk.CreateTmpDir()
+	modA := chk.CreateTmpSubDir("modA")
+	_ = chk.CreateTmpSubDir("modB", "pkg")
+	_ = chk.CreateTmpFileAs(tstDir, "go.work", []byte(
+		"go 1.25\n\nuse (\n\t./modA\n\t./modB\n)\n",
+	))
+
+	t.Chdir(modA)
+
+	cmd := ".." + sep + "modB" + sep + "pkg" + sep + "action"
+
+	dir, action, err := cmds.ParseCmd(cmd)
+	chk.NoErr(err)
+	chk.Str(dir, ".."+sep+"modB"+sep+"pkg")
+	chk.Str(action, "action")
+
+	dirs, actions, err := cmds.ParseCmds(cmd + " action2")
+	chk.NoErr(err)
+	chk.StrSlice(
+		dirs,
+		[]string{
+			".." + sep + "modB" + sep + "pkg",
+			".." + sep + "modB" + sep + "pkg",
+		},
+	)
+	chk.StrSlice(actions, []string{"action", "action2"})
+
+	cmd = ".." + sep + ".." + sep + "action"
+
+	dir, action, err = cmds.ParseCmd(cmd)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrOutsideWorkspace,
+			"\""+cmd+"\"",
+		),
+	)
+	chk.Str(dir, "")
+	chk.Str(action, "")
+}
+
+func Test_CmdParse_ParseCmd_WorkspaceSibling(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.DelEnv("GOWORK")
+
+	_ = chk.CreateTmpDir()
+	wsDir := chk.CreateTmpSubDir("ws")
+	modA := chk.CreateTmpSubDir("ws", "modA")
+	_ = chk.CreateTmpSubDir("sibling", "pkg")
+	_ = chk.CreateTmpSubDir("other")
+	_ = chk.CreateTmpFileAs(wsDir, "go.work", []byte(
+		"go 1.25\n\nuse (\n\t./modA\n\t../sibling\n)\n",
+	))
+
+	t.Chdir(modA)
+
+	cmd := ".." + sep + ".." + sep + "sibling" + sep + "pkg" + sep + "action"
+
+	dir, action, err := cmds.ParseCmd(cmd)
+	chk.NoErr(err)
+	chk.Str(dir, ".."+sep+".."+sep+"sibling"+sep+"pkg")
+	chk.Str(action, "action")
+
+	cmd = ".." + sep + ".." + sep + "other" + sep + "action"
+
+	dir, action, err = cmds.ParseCmd(cmd)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrOutsideWorkspace,
+			"\""+cmd+"\"",
+		),
+	)
+	chk.Str(dir, "")
+	chk.Str(action, "")
+}
@@ -0,0 +1,143 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// Options holds the "key=value" settings supplied with a directive.
+type Options map[string]string
+
+// Has returns true if the option was supplied.
+func (o Options) Has(key string) bool {
+	_, ok := o[key]
+
+	return ok
+}
+
+// Value returns the option's value or the default if it was not supplied.
+func (o Options) Value(key, def string) string {
+	if v, ok := o[key]; ok {
+		return v
+	}
+
+	return def
+}
+
+// Int returns the option's value as an integer or the default if it was not
+// supplied.
+func (o Options) Int(key string, def int) (int, error) {
+	v, ok := o[key]
+	if !ok {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return def, fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, v)
+	}
+
+	return i, nil
+}
+
+// Bool returns the option's value as a boolean or the default if it was not
+// supplied.
+func (o Options) Bool(key string, def bool) (bool, error) {
+	v, ok := o[key]
+	if !ok {
+		return def, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def, fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, v)
+	}
+
+	return b, nil
+}
+
+// ParseDuration parses either a go duration such as "1m30s" or a number of
+// seconds.  Only positive durations are valid.
+func ParseDuration(v string) (time.Duration, bool) {
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		var seconds int
+
+		seconds, err = strconv.Atoi(v)
+		d = time.Duration(seconds) * time.Second
+	}
+
+	return d, err == nil && d > 0
+}
+
+// Duration returns the option's value as a duration (see ParseDuration) or
+// the default if it was not supplied.
+func (o Options) Duration(
+	key string, def time.Duration,
+) (time.Duration, error) {
+	v, ok := o[key]
+	if !ok {
+		return def, nil
+	}
+
+	d, valid := ParseDuration(v)
+	if !valid {
+		return def, fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, v)
+	}
+
+	return d, nil
+}
+
+// ExtractOptions removes all "key=value" entries whose key is one of the
+// known keys from the command returning the remaining command (with its
+// entries separated by single spaces) and the options found.  Entries with
+// unknown keys are left in the command.
+func ExtractOptions(cmd string, known ...string) (string, Options, error) {
+	var (
+		remaining []string
+		opts      = make(Options)
+	)
+
+	for _, entry := range regexp.MustCompile(`[\s\t]+`).Split(
+		strings.TrimSpace(cmd), -1,
+	) {
+		key, value, found := strings.Cut(entry, "=")
+		if found && slices.Contains(known, key) {
+			if opts.Has(key) {
+				return "", nil, fmt.Errorf(
+					"%w: %q", errs.ErrDuplicateOption, key,
+				)
+			}
+
+			opts[key] = value
+		} else {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	return strings.Join(remaining, " "), opts, nil
+}
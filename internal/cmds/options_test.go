@@ -0,0 +1,139 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Options_Extract(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	cmd, opts, err := cmds.ExtractOptions(
+		" ./pkg/Func  tags=integration\tother=1 Func2 goos=windows ",
+		"tags", "goos", "goarch",
+	)
+	chk.NoErr(err)
+	chk.Str(cmd, "./pkg/Func other=1 Func2")
+	chk.True(opts.Has("tags"))
+	chk.Str(opts.Value("tags", "def"), "integration")
+	chk.Str(opts.Value("goos", "def"), "windows")
+	chk.False(opts.Has("goarch"))
+	chk.Str(opts.Value("goarch", "def"), "def")
+
+	cmd, opts, err = cmds.ExtractOptions("./pkg/Func", "tags")
+	chk.NoErr(err)
+	chk.Str(cmd, "./pkg/Func")
+	chk.Int(len(opts), 0)
+
+	cmd, opts, err = cmds.ExtractOptions("./pkg/Func tags=a tags=b", "tags")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrDuplicateOption,
+			"\"tags\"",
+		),
+	)
+	chk.Str(cmd, "")
+	chk.Nil(opts)
+}
+
+func Test_Options_Typed(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	opts := cmds.Options{
+		"count": "3",
+		"bad":   "x",
+		"flag":  "true",
+	}
+
+	i, err := opts.Int("count", 1)
+	chk.NoErr(err)
+	chk.Int(i, 3)
+
+	i, err = opts.Int("missing", 1)
+	chk.NoErr(err)
+	chk.Int(i, 1)
+
+	i, err = opts.Int("bad", 1)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"bad=\"x\"",
+		),
+	)
+	chk.Int(i, 1)
+
+	b, err := opts.Bool("flag", false)
+	chk.NoErr(err)
+	chk.True(b)
+
+	b, err = opts.Bool("missing", true)
+	chk.NoErr(err)
+	chk.True(b)
+
+	b, err = opts.Bool("bad", false)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"bad=\"x\"",
+		),
+	)
+	chk.False(b)
+}
+
+func Test_Options_Duration(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	opts := cmds.Options{
+		"go":       "1m30s",
+		"seconds":  "5",
+		"bad":      "x",
+		"negative": "-1s",
+	}
+
+	d, err := opts.Duration("go", time.Second)
+	chk.NoErr(err)
+	chk.Dur(d, 90*time.Second)
+
+	d, err = opts.Duration("seconds", time.Second)
+	chk.NoErr(err)
+	chk.Dur(d, 5*time.Second)
+
+	d, err = opts.Duration("missing", time.Second)
+	chk.NoErr(err)
+	chk.Dur(d, time.Second)
+
+	d, err = opts.Duration("bad", time.Second)
+	chk.Err(err, chk.ErrChain(errs.ErrInvalidOption, `bad="x"`))
+	chk.Dur(d, time.Second)
+
+	_, err = opts.Duration("negative", time.Second)
+	chk.Err(err, chk.ErrChain(errs.ErrInvalidOption, `negative="-1s"`))
+}
@@ -0,0 +1,180 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+)
+
+// Output modes selecting the streams displayed.
+const (
+	OutputCombined = "combined" // Both streams as written.
+	OutputStdout   = "stdout"   // Only the standard output.
+	OutputStderr   = "stderr"   // Only the standard error.
+	OutputBoth     = "both"     // Each stream in its own labelled block.
+	OutputMarked   = "marked"   // Both streams with stderr lines marked.
+)
+
+// OutputModes lists the valid output modes.
+//
+//nolint:goCheckNoGlobals // Ok.
+var OutputModes = []string{
+	OutputCombined, OutputStdout, OutputStderr, OutputBoth, OutputMarked,
+}
+
+// Prefix identifying standard error lines in the marked output mode.
+const stderrMark = "[stderr] "
+
+// Line is a single line written by a command to either its standard output
+// or standard error.
+type Line struct {
+	Stderr bool
+	Text   string
+}
+
+// Text joins the lines selected by keep (all lines if nil) prefixing any
+// standard error lines with the mark.
+func Text(lines []Line, keep func(Line) bool, mark string) string {
+	var text []string
+
+	for _, line := range lines {
+		if keep != nil && !keep(line) {
+			continue
+		}
+
+		if line.Stderr {
+			text = append(text, mark+line.Text)
+		} else {
+			text = append(text, line.Text)
+		}
+	}
+
+	return strings.Join(text, "\n")
+}
+
+func isStdout(line Line) bool {
+	return !line.Stderr
+}
+
+func isStderr(line Line) bool {
+	return line.Stderr
+}
+
+// Check returns an error including the command's output unless it exited
+// with the expected code.
+func (o *RunOptions) Check(cmd string, lines []Line, code int) error {
+	if code != o.ExpectExit {
+		return fmt.Errorf("%w: %d (expected %d): %s\n%s",
+			errs.ErrUnexpectedExit, code, o.ExpectExit, cmd,
+			Text(lines, nil, ""),
+		)
+	}
+
+	return nil
+}
+
+// streams returns the blocks of output (and their labels if separated)
+// selected by the options with the exit code appended to the last if it is
+// to be shown.
+func (o *RunOptions) streams(lines []Line, code int) ([]string, []string) {
+	var (
+		labels []string
+		blocks []string
+	)
+
+	switch o.Output {
+	case OutputStdout:
+		blocks = []string{Text(lines, isStdout, "")}
+	case OutputStderr:
+		blocks = []string{Text(lines, isStderr, "")}
+	case OutputMarked:
+		blocks = []string{Text(lines, nil, stderrMark)}
+	case OutputBoth:
+		labels = []string{"stdout:", "stderr:"}
+		blocks = []string{
+			Text(lines, isStdout, ""), Text(lines, isStderr, ""),
+		}
+	default:
+		blocks = []string{Text(lines, nil, "")}
+	}
+
+	for i := range blocks {
+		blocks[i] = o.Select.Apply(blocks[i])
+	}
+
+	if o.ShowExit {
+		last := len(blocks) - 1
+		if blocks[last] != "" {
+			blocks[last] += "\n"
+		}
+
+		blocks[last] += "$ echo $?\n" + strconv.Itoa(code)
+	}
+
+	return labels, blocks
+}
+
+// Result checks the exit code returned by the command against the expected
+// code returning the output formatted as selected by the options with the
+// exit code appended if it is to be shown.
+func (o *RunOptions) Result(
+	cmd string, lines []Line, code int,
+) (string, error) {
+	var res []string
+
+	err := o.Check(cmd, lines, code)
+	if err != nil {
+		return "", err
+	}
+
+	labels, blocks := o.streams(lines, code)
+
+	for i, block := range blocks {
+		switch {
+		case labels == nil:
+			res = append(res, format.Inline("", block))
+		case strings.TrimSpace(block) != "":
+			res = append(res, labels[i]+"\n\n"+format.Inline("", block))
+		}
+	}
+
+	return strings.Join(res, "\n\n"), nil
+}
+
+// Transcript returns the unframed output selected by the options (with
+// separated streams following one another) as it would appear in a
+// terminal.
+func (o *RunOptions) Transcript(lines []Line, code int) string {
+	var res []string
+
+	_, blocks := o.streams(lines, code)
+
+	for _, block := range blocks {
+		if block != "" {
+			res = append(res, block)
+		}
+	}
+
+	return strings.Join(res, "\n")
+}
@@ -0,0 +1,149 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+//nolint:goCheckNoGlobals // Ok for test.
+var outputLines = []cmds.Line{
+	{Stderr: false, Text: "out 1"},
+	{Stderr: true, Text: "err 1"},
+	{Stderr: false, Text: "out 2"},
+}
+
+func result(
+	chk *sztest.Chk, cmd string, lines []cmds.Line, code int,
+) (string, error) {
+	_, opts, err := cmds.ParseRunCmd(cmd, time.Minute)
+	chk.NoErr(err)
+
+	return opts.Result("prog", lines, code)
+}
+
+func Test_Output_Text(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(cmds.Text(nil, nil, ""), "")
+	chk.Str(cmds.Text(outputLines, nil, ""), "out 1\nerr 1\nout 2")
+	chk.Str(cmds.Text(outputLines, nil, "E: "), "out 1\nE: err 1\nout 2")
+	chk.Str(
+		cmds.Text(outputLines, func(l cmds.Line) bool { return l.Stderr }, ""),
+		"err 1",
+	)
+}
+
+func Test_Output_ExitCode(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	out, err := result(chk, "prog", outputLines, 0)
+	chk.NoErr(err)
+	chk.Str(out, "```\nout 1\nerr 1\nout 2\n```")
+
+	out, err = result(chk, "prog", outputLines, 1)
+	chk.Err(err, ""+
+		errs.ErrUnexpectedExit.Error()+": 1 (expected 0): prog\n"+
+		"out 1\nerr 1\nout 2",
+	)
+	chk.Str(out, "")
+
+	out, err = result(chk, "expect-exit=2 prog", outputLines, 2)
+	chk.NoErr(err)
+	chk.Str(out, "```\nout 1\nerr 1\nout 2\n$ echo $?\n2\n```")
+
+	out, err = result(chk, "expect-exit=2 prog", nil, 2)
+	chk.NoErr(err)
+	chk.Str(out, "```\n$ echo $?\n2\n```")
+
+	out, err = result(chk, "expect-exit=2 show-exit=false prog", nil, 2)
+	chk.NoErr(err)
+	chk.Str(out, "")
+
+	_, _, err = cmds.ParseRunCmd("expect-exit=-1 prog", time.Minute)
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: expect-exit="-1"`)
+
+	_, _, err = cmds.ParseRunCmd("expect-exit=x prog", time.Minute)
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: expect-exit="x"`)
+
+	_, _, err = cmds.ParseRunCmd("show-exit=maybe prog", time.Minute)
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: show-exit="maybe"`)
+}
+
+func Test_Output_Modes(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	out, err := result(chk, "output=stdout prog", outputLines, 0)
+	chk.NoErr(err)
+	chk.Str(out, "```\nout 1\nout 2\n```")
+
+	out, err = result(chk, "output=stderr prog", outputLines, 0)
+	chk.NoErr(err)
+	chk.Str(out, "```\nerr 1\n```")
+
+	out, err = result(chk, "output=marked prog", outputLines, 0)
+	chk.NoErr(err)
+	chk.Str(out, "```\nout 1\n[stderr] err 1\nout 2\n```")
+
+	out, err = result(chk, "output=both prog", outputLines, 0)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		"stdout:\n\n```\nout 1\nout 2\n```\n\n"+
+		"stderr:\n\n```\nerr 1\n```",
+	)
+
+	out, err = result(chk, "output=both expect-exit=1 prog",
+		outputLines[:1], 1,
+	)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		"stdout:\n\n```\nout 1\n```\n\n"+
+		"stderr:\n\n```\n$ echo $?\n1\n```",
+	)
+
+	_, _, err = cmds.ParseRunCmd("output=all prog", time.Minute)
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: output="all"`)
+}
+
+func Test_Output_Transcript(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_, opts, err := cmds.ParseRunCmd("output=both prog", time.Minute)
+	chk.NoErr(err)
+	chk.Str(opts.Transcript(outputLines, 0), "out 1\nout 2\nerr 1")
+	chk.Str(opts.Transcript(nil, 0), "")
+
+	_, opts, err = cmds.ParseRunCmd("expect-exit=3 prog", time.Minute)
+	chk.NoErr(err)
+	chk.NoErr(opts.Check("prog", nil, 3))
+	chk.Str(
+		opts.Transcript(outputLines, 3),
+		"out 1\nerr 1\nout 2\n$ echo $?\n3",
+	)
+}
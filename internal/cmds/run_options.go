@@ -0,0 +1,340 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// Options that may precede a command run by a directive.
+const (
+	OptCwd        = "cwd"
+	OptEnv        = "env"
+	OptStdin      = "stdin"
+	OptTimeout    = "timeout"
+	OptExpectExit = "expect-exit"
+	OptShowExit   = "show-exit"
+	OptOutput     = "output"
+	OptAs         = "as"
+)
+
+//nolint:goCheckNoGlobals // Ok.
+var runOptionKeys = append([]string{
+	OptCwd, OptEnv, OptStdin, OptTimeout, OptExpectExit, OptShowExit,
+	OptOutput, OptAs,
+}, SelectionKeys...)
+
+const maxExitCode = 255
+
+const heredocPrefix = "<<"
+
+// "stdin=<<EOF".
+var heredocStart = regexp.MustCompile(
+	`(?:^|\s)` + OptStdin + `=` + heredocPrefix + `(\w+)(?:\s|$)`,
+)
+
+// RunOptions holds the options that may precede a command run by a
+// directive.
+type RunOptions struct {
+	Dir        string        // Relative directory to run in.
+	Env        []string      // Additional "KEY=value" environment entries.
+	StdinFile  string        // File providing the standard input.
+	StdinMark  string        // Heredoc marker delimiting the standard input.
+	Stdin      []byte        // The standard input (empty if not provided).
+	Timeout    time.Duration // Maximum run time.
+	ExpectExit int           // Exit code the command must return.
+	ShowExit   bool          // Display the exit code after the output.
+	Output     string        // Output streams displayed (see OutputModes).
+	As         string        // Program name displayed for the command.
+	Select     *Selection    // Lines of output displayed (nil for all).
+}
+
+func localDir(base, dir string) (string, error) {
+	dir = filepath.Clean(dir)
+
+	if !filepath.IsLocal(dir) && dir != "." {
+		return "", fmt.Errorf("%w: %q", errs.ErrNotLocalDir, dir)
+	}
+
+	stat, err := os.Stat(filepath.Join(base, dir))
+	if err != nil || !stat.IsDir() {
+		return "", fmt.Errorf("%w: %q", errs.ErrInvalidDirectory, dir)
+	}
+
+	return dir, nil
+}
+
+func readLocal(base, path string) ([]byte, error) {
+	path = filepath.Clean(path)
+
+	if !filepath.IsLocal(path) {
+		return nil, fmt.Errorf("%w: %q", errs.ErrNotLocalDir, path)
+	}
+
+	//nolint:wrapcheck // Caller will wrap error.
+	return os.ReadFile(filepath.Join(base, path))
+}
+
+// splitHeredoc removes the body of a "stdin=<<EOF" heredoc (the lines
+// following it up to a line containing only EOF) from the command returning
+// the remaining command, the body and its marker.
+func splitHeredoc(cmd string) (string, string, string, error) {
+	loc := heredocStart.FindStringSubmatchIndex(cmd)
+	if loc == nil {
+		return cmd, "", "", nil
+	}
+
+	mark := cmd[loc[2]:loc[3]]
+
+	lineEnd := strings.IndexByte(cmd[loc[3]:], '\n')
+	if lineEnd >= 0 {
+		bodyStart := loc[3] + lineEnd + 1
+		lines := strings.Split(cmd[bodyStart:], "\n")
+
+		for i, line := range lines {
+			if strings.TrimSpace(line) == mark {
+				return cmd[:bodyStart] + strings.Join(lines[i+1:], "\n"),
+					strings.Join(lines[:i], "\n"),
+					mark,
+					nil
+			}
+		}
+	}
+
+	return "", "", "", fmt.Errorf(
+		"%w: %s", errs.ErrUnterminatedHeredoc, heredocPrefix+mark,
+	)
+}
+
+// OpenHeredoc returns true if the command starts a "stdin=<<EOF" heredoc
+// whose closing EOF line has not yet been reached.
+func OpenHeredoc(cmd string) bool {
+	_, _, _, err := splitHeredoc(cmd)
+
+	return err != nil
+}
+
+// parseOption applies a single leading "key=value" option returning false
+// if the word is not an option (and therefore starts the command).
+func (o *RunOptions) parseOption(
+	base, word string, seen Options,
+) (bool, error) {
+	var err error
+
+	key, value, found := strings.Cut(word, "=")
+	if !found || !slices.Contains(runOptionKeys, key) {
+		return false, nil
+	}
+
+	if key != OptEnv && seen.Has(key) {
+		return true, fmt.Errorf("%w: %q", errs.ErrDuplicateOption, key)
+	}
+
+	seen[key] = value
+
+	switch key {
+	case OptCwd:
+		o.Dir, err = localDir(base, value)
+	case OptEnv:
+		if !strings.Contains(value, "=") {
+			err = fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, value)
+		}
+
+		o.Env = append(o.Env, value)
+	case OptStdin:
+		if !strings.HasPrefix(value, heredocPrefix) {
+			o.StdinFile = value
+			o.Stdin, err = readLocal(base, value)
+		}
+	case OptExpectExit:
+		o.ExpectExit, err = seen.Int(OptExpectExit, 0)
+		if err == nil && (o.ExpectExit < 0 || o.ExpectExit > maxExitCode) {
+			err = fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, value)
+		}
+	case OptShowExit:
+		o.ShowExit, err = seen.Bool(OptShowExit, false)
+	case OptOutput:
+		o.Output = value
+		if !slices.Contains(OutputModes, value) {
+			err = fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, value)
+		}
+	case OptHead, OptTail, OptLines, OptGrep:
+		// Combined into the selection once all options are known.
+	case OptAs:
+		o.As = value
+		if strings.TrimSpace(value) == "" {
+			err = fmt.Errorf("%w: %s=%q", errs.ErrInvalidOption, key, value)
+		}
+	default:
+		o.Timeout, err = seen.Duration(OptTimeout, o.Timeout)
+	}
+
+	return true, err
+}
+
+// ParseRunCmd splits the command into shell-like words removing and
+// returning any leading options ("cwd=dir", "env=KEY=value" (repeatable),
+// "stdin=file", "timeout=duration", "expect-exit=code", "show-exit=bool",
+// "output=mode", "as=name" and the selection options "head=n", "tail=n",
+// "lines=from-to" and "grep=regex").  Standard input may also be
+// supplied inline with "stdin=<<EOF" followed by the lines up to one
+// containing only EOF.
+func ParseRunCmd(
+	cmd string, timeout time.Duration,
+) ([]string, *RunOptions, error) {
+	return ParseRunCmdIn(".", cmd, timeout)
+}
+
+// ParseRunCmdIn parses the command as ParseRunCmd with the relative
+// directory and input file options resolved from the base directory.
+func ParseRunCmdIn(
+	base, cmd string, timeout time.Duration,
+) ([]string, *RunOptions, error) {
+	var (
+		words    []string
+		body     string
+		mark     string
+		isOption = true
+		seen     = make(Options)
+		opts     = &RunOptions{
+			Dir:        ".",
+			Env:        nil,
+			StdinFile:  "",
+			StdinMark:  "",
+			Stdin:      nil,
+			Timeout:    timeout,
+			ExpectExit: 0,
+			ShowExit:   false,
+			Output:     OutputCombined,
+			As:         "",
+			Select:     nil,
+		}
+		err error
+	)
+
+	cmd, body, mark, err = splitHeredoc(cmd)
+
+	if err == nil {
+		words, err = SplitWords(cmd)
+	}
+
+	for len(words) > 0 && isOption && err == nil {
+		isOption, err = opts.parseOption(base, words[0], seen)
+		if isOption {
+			words = words[1:]
+		}
+	}
+
+	if err == nil {
+		opts.Select, err = NewSelection(seen)
+	}
+
+	if err == nil && !seen.Has(OptShowExit) {
+		// Show expected failures by default.
+		opts.ShowExit = opts.ExpectExit != 0
+	}
+
+	stdin := seen.Value(OptStdin, "")
+	if err == nil && (mark != "" || strings.HasPrefix(stdin, heredocPrefix)) {
+		if !seen.Has(OptStdin) {
+			// Heredoc not supplied as a leading option.
+			stdin = heredocPrefix + mark
+		}
+
+		valid := seen.Has(OptStdin) && mark != "" &&
+			stdin == heredocPrefix+mark
+		if !valid {
+			err = fmt.Errorf(
+				"%w: %s=%q", errs.ErrInvalidOption, OptStdin, stdin,
+			)
+		}
+
+		opts.StdinMark = mark
+		opts.Stdin = []byte(body)
+
+		if body != "" {
+			opts.Stdin = append(opts.Stdin, '\n')
+		}
+	}
+
+	if err == nil {
+		return words, opts, nil
+	}
+
+	return nil, nil, err
+}
+
+// Program returns the words naming the program run unless replaced by the
+// name to display for it.
+func (o *RunOptions) Program(words ...string) []string {
+	if o.As != "" {
+		return []string{o.As}
+	}
+
+	return words
+}
+
+// Command returns the words as a shell command line that also reproduces
+// the options by changing to the directory, setting the environment and
+// redirecting the standard input.
+func (o *RunOptions) Command(words []string) string {
+	var cmd strings.Builder
+
+	if o.Dir != "." {
+		cmd.WriteString("cd " + QuoteWord(o.Dir) + " && ")
+	}
+
+	for _, e := range o.Env {
+		key, value, _ := strings.Cut(e, "=")
+		cmd.WriteString(key + "=" + QuoteWord(value) + " ")
+	}
+
+	cmd.WriteString(QuoteWords(words))
+	cmd.WriteString(o.Redirect())
+
+	return cmd.String()
+}
+
+// Redirect returns the shell redirection (if any) supplying the standard
+// input from the options.
+func (o *RunOptions) Redirect() string {
+	switch {
+	case o.StdinFile != "":
+		path := filepath.Clean(o.StdinFile)
+
+		rel, err := filepath.Rel(o.Dir, path)
+		if err == nil {
+			path = rel
+		}
+
+		return " < " + QuoteWord(path)
+	case o.StdinMark != "":
+		return " <<'" + o.StdinMark + "'\n" + string(o.Stdin) + o.StdinMark
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,190 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_RunOptions_Defaults(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, opts, err := cmds.ParseRunCmd("make 'a b' timeout=1", time.Minute)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"make", "a b", "timeout=1"})
+	chk.Str(opts.Dir, ".")
+	chk.StrSlice(opts.Env, nil)
+	chk.Str(opts.StdinFile, "")
+	chk.Str(opts.StdinMark, "")
+	chk.Int(len(opts.Stdin), 0)
+	chk.Dur(opts.Timeout, time.Minute)
+	chk.Str(opts.Command(words), "make 'a b' timeout=1")
+	chk.StrSlice(opts.Program("go", "run", "."), []string{"go", "run", "."})
+}
+
+func Test_RunOptions_As(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, opts, err := cmds.ParseRunCmd(
+		"as='my tool' go run ./cmd/tool --help", time.Minute,
+	)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"go", "run", "./cmd/tool", "--help"})
+	chk.Str(opts.As, "my tool")
+	chk.Str(
+		opts.Command(append(opts.Program(words[:3]...), words[3:]...)),
+		"'my tool' --help",
+	)
+}
+
+func Test_RunOptions_Options(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpSubDir("sub")
+	_ = chk.CreateTmpFileAs(tstDir, "input.txt", []byte("data\n"))
+
+	t.Chdir(tstDir)
+
+	words, opts, err := cmds.ParseRunCmd(""+
+		"cwd=sub env=A=1 env='B=two words' env=C= stdin=./input.txt "+
+		"timeout=5 cat -n",
+		time.Minute,
+	)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"cat", "-n"})
+	chk.Str(opts.Dir, "sub")
+	chk.StrSlice(opts.Env, []string{"A=1", "B=two words", "C="})
+	chk.Str(opts.StdinFile, "./input.txt")
+	chk.Str(string(opts.Stdin), "data\n")
+	chk.Dur(opts.Timeout, 5*time.Second)
+	chk.Str(
+		opts.Command(words),
+		"cd sub && A=1 B='two words' C='' cat -n < ../input.txt",
+	)
+}
+
+func Test_RunOptions_Heredoc(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, opts, err := cmds.ParseRunCmd(""+
+		"stdin=<<END sort\n"+
+		"  b\n"+
+		"a\n"+
+		"END\n"+
+		"-r",
+		time.Minute,
+	)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"sort", "-r"})
+	chk.Str(opts.StdinMark, "END")
+	chk.Str(string(opts.Stdin), "  b\na\n")
+	chk.Str(opts.Command(words), "sort -r <<'END'\n  b\na\nEND")
+
+	words, opts, err = cmds.ParseRunCmd("stdin=<<END sort\nEND", time.Minute)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"sort"})
+	chk.Str(string(opts.Stdin), "")
+	chk.Str(opts.Command(words), "sort <<'END'\nEND")
+}
+
+func Test_RunOptions_In(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpSubDir("sub")
+	_ = chk.CreateTmpFileAs(tstDir, "input.txt", []byte("data\n"))
+
+	words, opts, err := cmds.ParseRunCmdIn(
+		tstDir, "cwd=sub stdin=input.txt cat", time.Minute,
+	)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"cat"})
+	chk.Str(opts.Dir, "sub")
+	chk.Str(string(opts.Stdin), "data\n")
+	chk.Str(opts.Redirect(), " < ../input.txt")
+
+	_, _, err = cmds.ParseRunCmd("cwd=sub cat", time.Minute)
+	chk.Err(err, errs.ErrInvalidDirectory.Error()+`: "sub"`)
+}
+
+func Test_RunOptions_OpenHeredoc(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.False(cmds.OpenHeredoc("cat"))
+	chk.True(cmds.OpenHeredoc("stdin=<<EOF cat"))
+	chk.True(cmds.OpenHeredoc("stdin=<<EOF cat\nline"))
+	chk.False(cmds.OpenHeredoc("stdin=<<EOF cat\nline\nEOF"))
+}
+
+func Test_RunOptions_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := chk.CreateTmpDir()
+	t.Chdir(tstDir)
+
+	for i, tst := range []struct {
+		cmd    string
+		expErr string
+	}{
+		/* Idx:  0 */ {"echo 'open", errs.ErrUnterminatedQuote.Error() +
+			": echo 'open"},
+		/* Idx:  1 */ {"cwd=. cwd=. echo", errs.ErrDuplicateOption.Error() +
+			`: "cwd"`},
+		/* Idx:  2 */ {"cwd=missing echo", errs.ErrInvalidDirectory.Error() +
+			`: "missing"`},
+		/* Idx:  3 */ {"cwd=../x echo", errs.ErrNotLocalDir.Error() +
+			`: "../x"`},
+		/* Idx:  4 */ {"env=NOVALUE echo", errs.ErrInvalidOption.Error() +
+			`: env="NOVALUE"`},
+		/* Idx:  5 */ {"stdin=/etc/passwd cat", errs.ErrNotLocalDir.Error() +
+			`: "/etc/passwd"`},
+		/* Idx:  6 */ {"stdin=missing.txt cat",
+			"open missing.txt: no such file or directory"},
+		/* Idx:  7 */ {"timeout=soon echo", errs.ErrInvalidOption.Error() +
+			`: timeout="soon"`},
+		/* Idx:  8 */ {"stdin=<<EOF cat\nline",
+			errs.ErrUnterminatedHeredoc.Error() + ": <<EOF"},
+		/* Idx:  9 */ {"stdin=<<EOF", errs.ErrUnterminatedHeredoc.Error() +
+			": <<EOF"},
+		/* Idx: 10 */ {"cat stdin=<<EOF\nline\nEOF",
+			errs.ErrInvalidOption.Error() + `: stdin="<<EOF"`},
+		/* Idx: 11 */ {"stdin=<< cat", errs.ErrInvalidOption.Error() +
+			`: stdin="<<"`},
+		/* Idx: 12 */ {"as= cat", errs.ErrInvalidOption.Error() +
+			`: as=""`},
+	} {
+		words, opts, err := cmds.ParseRunCmd(tst.cmd, time.Minute)
+		chk.Err(err, tst.expErr, "Idx: ", i)
+		chk.StrSlice(words, nil, "Idx: ", i)
+		chk.True(opts == nil, "Idx: ", i)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// Options selecting the lines of output displayed.
+const (
+	OptHead  = "head"
+	OptTail  = "tail"
+	OptLines = "lines"
+	OptGrep  = "grep"
+)
+
+// SelectionKeys lists the options selecting the lines of output displayed.
+//
+//nolint:goCheckNoGlobals // Ok.
+var SelectionKeys = []string{OptHead, OptTail, OptLines, OptGrep}
+
+// ElisionMark replaces each run of lines removed from the output.
+const ElisionMark = "..."
+
+// "5-30", "5-" or "5".
+var outputRangeRe = regexp.MustCompile(`^(\d+)(-(\d*))?$`)
+
+// Selection describes the lines of output to display.  Lines are first
+// limited to the range (from and to starting at 1 with zero meaning
+// unlimited) then to those matching grep before keeping only the first
+// head and last tail lines.
+type Selection struct {
+	Head int
+	Tail int
+	From int
+	To   int
+	Grep *regexp.Regexp
+}
+
+func parseOutputRange(value string) (int, int, error) {
+	var (
+		from, to int
+		err      error
+	)
+
+	match := outputRangeRe.FindStringSubmatch(value)
+	if match == nil {
+		return 0, 0, fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidRange, OptLines, value,
+		)
+	}
+
+	from, err = strconv.Atoi(match[1])
+	to = from
+
+	switch {
+	case err == nil && match[3] != "":
+		to, err = strconv.Atoi(match[3])
+	case match[2] != "":
+		to = 0 // "5-" continues to the last line.
+	}
+
+	if err == nil && (from < 1 || (to != 0 && to < from)) {
+		err = fmt.Errorf("%w: %s=%q", errs.ErrInvalidRange, OptLines, value)
+	}
+
+	return from, to, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+func positive(opts Options, key string) (int, error) {
+	n, err := opts.Int(key, 0)
+	if err == nil && n < 0 {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, key, opts.Value(key, ""),
+		)
+	}
+
+	return n, err
+}
+
+// NewSelection returns the selection described by the options (nil if no
+// selection options were supplied).
+func NewSelection(opts Options) (*Selection, error) {
+	var (
+		sel = new(Selection)
+		err error
+	)
+
+	if !opts.Has(OptHead) && !opts.Has(OptTail) &&
+		!opts.Has(OptLines) && !opts.Has(OptGrep) {
+		return nil, nil //nolint:nilnil // No selection is not an error.
+	}
+
+	sel.Head, err = positive(opts, OptHead)
+
+	if err == nil {
+		sel.Tail, err = positive(opts, OptTail)
+	}
+
+	if err == nil && opts.Has(OptLines) {
+		sel.From, sel.To, err = parseOutputRange(opts.Value(OptLines, ""))
+	}
+
+	if err == nil && opts.Has(OptGrep) {
+		sel.Grep, err = regexp.Compile(opts.Value(OptGrep, ""))
+		if err != nil {
+			err = fmt.Errorf("%w: %s=%q: %w",
+				errs.ErrInvalidOption, OptGrep, opts.Value(OptGrep, ""), err,
+			)
+		}
+	}
+
+	if err == nil {
+		return sel, nil
+	}
+
+	return nil, err
+}
+
+// kept returns the indexes of the selected lines.
+func (s *Selection) kept(lines []string) []int {
+	kept := make([]int, 0, len(lines))
+
+	for i, line := range lines {
+		inRange := i+1 >= s.From && (s.To == 0 || i+1 <= s.To)
+		if inRange && (s.Grep == nil || s.Grep.MatchString(line)) {
+			kept = append(kept, i)
+		}
+	}
+
+	if (s.Head > 0 || s.Tail > 0) && s.Head+s.Tail < len(kept) {
+		kept = append(kept[:s.Head:s.Head], kept[len(kept)-s.Tail:]...)
+	}
+
+	return kept
+}
+
+// Apply returns the selected lines of the text marking the place of every
+// run of lines removed with the ElisionMark.  Any final newline is kept.  A
+// nil selection returns the text unchanged.
+func (s *Selection) Apply(text string) string {
+	if s == nil || text == "" {
+		return text
+	}
+
+	var (
+		body, final = strings.CutSuffix(text, "\n")
+		lines       = strings.Split(body, "\n")
+		res         []string
+		next        int
+	)
+
+	for _, i := range s.kept(lines) {
+		if i > next {
+			res = append(res, ElisionMark)
+		}
+
+		res = append(res, lines[i])
+		next = i + 1
+	}
+
+	if next < len(lines) {
+		res = append(res, ElisionMark)
+	}
+
+	if final {
+		res = append(res, "")
+	}
+
+	return strings.Join(res, "\n")
+}
@@ -0,0 +1,149 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztestlog"
+)
+
+// "line 1\nline 2\n...line 10".
+func numbered(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = "line " + strconv.Itoa(i+1)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func selection(t *testing.T, opts cmds.Options) *cmds.Selection {
+	t.Helper()
+
+	sel, err := cmds.NewSelection(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sel
+}
+
+func Test_Selection_None(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	sel := selection(t, cmds.Options{"timeout": "5"})
+	chk.True(sel == nil)
+	chk.Str(sel.Apply(numbered(3)), numbered(3))
+	chk.Str(selection(t, cmds.Options{"head": "2"}).Apply(""), "")
+}
+
+func Test_Selection_Apply(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	for i, tst := range []struct {
+		opts cmds.Options
+		exp  string
+	}{
+		/* Idx:  0 */ {cmds.Options{"head": "2"},
+			"line 1\nline 2\n..."},
+		/* Idx:  1 */ {cmds.Options{"tail": "2"},
+			"...\nline 9\nline 10"},
+		/* Idx:  2 */ {cmds.Options{"head": "1", "tail": "1"},
+			"line 1\n...\nline 10"},
+		/* Idx:  3 */ {cmds.Options{"head": "6", "tail": "6"},
+			numbered(10)},
+		/* Idx:  4 */ {cmds.Options{"lines": "4-6"},
+			"...\nline 4\nline 5\nline 6\n..."},
+		/* Idx:  5 */ {cmds.Options{"lines": "9-"},
+			"...\nline 9\nline 10"},
+		/* Idx:  6 */ {cmds.Options{"lines": "3"},
+			"...\nline 3\n..."},
+		/* Idx:  7 */ {cmds.Options{"lines": "8-20"},
+			"...\nline 8\nline 9\nline 10"},
+		/* Idx:  8 */ {cmds.Options{"grep": `[13579]$`},
+			"line 1\n...\nline 3\n...\nline 5\n...\nline 7\n...\nline 9\n..."},
+		/* Idx:  9 */ {cmds.Options{"grep": `^line 1`},
+			"line 1\n...\nline 10"},
+		/* Idx: 10 */ {cmds.Options{"grep": `missing`},
+			"..."},
+		/* Idx: 11 */ {
+			cmds.Options{"lines": "2-9", "grep": "[2-8]", "head": "2"},
+			"...\nline 2\nline 3\n..."},
+	} {
+		sel := selection(t, tst.opts)
+		chk.Str(sel.Apply(numbered(10)), tst.exp, "Idx: ", i)
+		chk.Str(sel.Apply(numbered(10)+"\n"), tst.exp+"\n", "Idx: ", i)
+	}
+}
+
+func Test_Selection_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	for i, tst := range []struct {
+		opts   cmds.Options
+		expErr string
+	}{
+		/* Idx:  0 */ {cmds.Options{"head": "x"},
+			errs.ErrInvalidOption.Error() + `: head="x"`},
+		/* Idx:  1 */ {cmds.Options{"tail": "-1"},
+			errs.ErrInvalidOption.Error() + `: tail="-1"`},
+		/* Idx:  2 */ {cmds.Options{"lines": "0-3"},
+			errs.ErrInvalidRange.Error() + `: lines="0-3"`},
+		/* Idx:  3 */ {cmds.Options{"lines": "5-3"},
+			errs.ErrInvalidRange.Error() + `: lines="5-3"`},
+		/* Idx:  4 */ {cmds.Options{"lines": "L1-L3"},
+			errs.ErrInvalidRange.Error() + `: lines="L1-L3"`},
+		/* Idx:  5 */ {cmds.Options{"grep": "("},
+			errs.ErrInvalidOption.Error() + `: grep="(": ` +
+				"error parsing regexp: missing closing ): `(`"},
+	} {
+		sel, err := cmds.NewSelection(tst.opts)
+		chk.Err(err, tst.expErr, "Idx: ", i)
+		chk.True(sel == nil, "Idx: ", i)
+	}
+}
+
+func Test_Selection_RunOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, opts, err := cmds.ParseRunCmd(
+		"head=1 grep='^[a-z]+ 2' prog head=3", 0,
+	)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"prog", "head=3"})
+	chk.Int(opts.Select.Head, 1)
+	chk.Str(opts.Select.Grep.String(), "^[a-z]+ 2")
+	chk.Str(
+		opts.Transcript([]cmds.Line{
+			{Stderr: false, Text: "out 1"},
+			{Stderr: true, Text: "err 2"},
+			{Stderr: false, Text: "out 2"},
+		}, 0),
+		"...\nerr 2\n...",
+	)
+}
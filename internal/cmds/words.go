@@ -0,0 +1,122 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// Characters requiring a word to be quoted when displayed.
+const shellSpecial = " \t\n\"'\\$`|&;<>()*?[]#~!{}"
+
+// SplitWords splits the command into words following the quoting rules of a
+// POSIX shell: single quotes preserve their contents literally while double
+// quotes and unquoted text honor backslash escapes.  No expansions of any
+// kind are performed.
+//
+//nolint:cyclop // Ok.
+func SplitWords(cmd string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range cmd {
+		switch {
+		case escaped:
+			switch {
+			case r == '\n': // Line continuation.
+			case quote == '"' && !strings.ContainsRune("\"\\$`", r):
+				word.WriteRune('\\')
+				word.WriteRune(r)
+			default:
+				word.WriteRune(r)
+			}
+
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+
+			inWord = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("%w: %s", errs.ErrUnterminatedQuote, cmd)
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
+
+// QuoteWord returns the word quoted (if necessary) so that SplitWords (or a
+// shell) would return it unchanged.
+func QuoteWord(word string) string {
+	if word == "" {
+		return "''"
+	}
+
+	if !strings.ContainsAny(word, shellSpecial) {
+		return word
+	}
+
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
+
+// QuoteWords joins the words quoting each as necessary.
+func QuoteWords(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = QuoteWord(word)
+	}
+
+	return strings.Join(quoted, " ")
+}
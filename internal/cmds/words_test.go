@@ -0,0 +1,80 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package cmds_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Words_Split(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	words, err := cmds.SplitWords("")
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{})
+
+	words, err = cmds.SplitWords("  make   help\t")
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"make", "help"})
+
+	words, err = cmds.SplitWords(`echo 'a  b' "c \"d\" \$e \x" f\ g ''`)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"echo", "a  b", `c "d" $e \x`, "f g", ""})
+
+	words, err = cmds.SplitWords(`say 'it'\''s' mid"dle"word`)
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"say", "it's", "middleword"})
+
+	words, err = cmds.SplitWords("one \\\ntwo\nthree")
+	chk.NoErr(err)
+	chk.StrSlice(words, []string{"one", "two", "three"})
+
+	_, err = cmds.SplitWords(`echo 'open`)
+	chk.Err(err, chk.ErrChain(errs.ErrUnterminatedQuote, `echo 'open`))
+
+	_, err = cmds.SplitWords(`echo "open`)
+	chk.Err(err, chk.ErrChain(errs.ErrUnterminatedQuote, `echo "open`))
+
+	_, err = cmds.SplitWords(`echo \`)
+	chk.Err(err, chk.ErrChain(errs.ErrUnterminatedQuote, `echo \`))
+}
+
+func Test_Words_Quote(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(cmds.QuoteWord(""), "''")
+	chk.Str(cmds.QuoteWord("plain-word_1.go"), "plain-word_1.go")
+	chk.Str(cmds.QuoteWord("a b"), "'a b'")
+	chk.Str(cmds.QuoteWord("it's"), `'it'\''s'`)
+	chk.Str(cmds.QuoteWord("$HOME"), "'$HOME'")
+
+	words := []string{"echo", "a  b", "it's", "", "--flag=x y"}
+	quoted := cmds.QuoteWords(words)
+	chk.Str(quoted, `echo 'a  b' 'it'\''s' '' '--flag=x y'`)
+
+	split, err := cmds.SplitWords(quoted)
+	chk.NoErr(err)
+	chk.StrSlice(split, words)
+}
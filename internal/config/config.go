@@ -0,0 +1,209 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/szlog"
+)
+
+// FileName is the name of the project configuration file.  It is searched
+// for in the template's directory and each parent directory up to the root
+// of the module containing it.
+const FileName = ".gotomd.json"
+
+// Exec holds the settings for the exec directive.
+type Exec struct {
+	// Allow lists the programs that may be run either by name or by a path
+	// relative to the template's directory.  Exec is disabled if empty.
+	Allow []string `json:"allow"`
+}
+
+// Rule replaces all matches of a regular expression in command output.
+type Rule struct {
+	// Match is the regular expression (applied to each line) to replace.
+	Match string `json:"match"`
+	// Replace is the replacement which may refer to submatches as ${1}.
+	Replace string `json:"replace"`
+}
+
+// Normalize holds the rules applied to the output of every command run by a
+// directive.
+type Normalize struct {
+	// Builtin enables the built in rules for common noise (the default).
+	Builtin *bool `json:"builtin"`
+	// Rules lists additional rules applied after any built in rules.
+	Rules []Rule `json:"rules"`
+}
+
+// Config holds the project configuration.
+type Config struct {
+	path string
+
+	Exec      Exec      `json:"exec"`
+	Normalize Normalize `json:"normalize"`
+}
+
+//nolint:goCheckNoGlobals // Ok.
+var configCache = make(map[string]*Config)
+
+// Reset clears all cached configurations.
+func Reset() {
+	for k := range configCache {
+		delete(configCache, k)
+	}
+}
+
+// Path returns the file the configuration was loaded from or an empty
+// string if no configuration file was found.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// ExecAllowed returns true if the program is in the exec allow-list.
+func (c *Config) ExecAllowed(program string) bool {
+	return c.ExecAllowedIn(program, ".")
+}
+
+// ExecAllowedIn returns true if the program run from the directory is in
+// the exec allow-list.  A program named by a path is resolved against the
+// directory and compared with the cleaned paths in the allow-list.
+func (c *Config) ExecAllowedIn(program, dir string) bool {
+	if !strings.ContainsRune(program, os.PathSeparator) {
+		return slices.Contains(c.Exec.Allow, program)
+	}
+
+	path := filepath.Clean(program)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	return slices.ContainsFunc(c.Exec.Allow, func(allowed string) bool {
+		return strings.ContainsRune(allowed, os.PathSeparator) &&
+			filepath.Clean(allowed) == path
+	})
+}
+
+// BuiltinRules returns true unless the built in normalization rules have
+// been disabled.
+func (c *Config) BuiltinRules() bool {
+	return c.Normalize.Builtin == nil || *c.Normalize.Builtin
+}
+
+// find searches the directory and its parents for the configuration file
+// stopping at the first directory containing a go.mod file.
+func find(dir string) (string, error) {
+	for {
+		path := filepath.Join(dir, FileName)
+
+		_, err := os.Stat(path)
+		if err == nil {
+			return path, nil
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err //nolint:wrapcheck // Caller will wrap error.
+		}
+
+		_, err = os.Stat(filepath.Join(dir, "go.mod"))
+
+		parent := filepath.Dir(dir)
+		if err == nil || parent == dir {
+			return "", nil
+		}
+
+		dir = parent
+	}
+}
+
+func parse(path string) (*Config, error) {
+	cfg := &Config{
+		path:      path,
+		Exec:      Exec{Allow: nil},
+		Normalize: Normalize{Builtin: nil, Rules: nil},
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // Ok.
+
+	if err == nil {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+
+		err = decoder.Decode(cfg)
+		if err != nil {
+			err = fmt.Errorf("%w: %s: %w", errs.ErrInvalidConfig, path, err)
+		}
+	}
+
+	if err == nil {
+		return cfg, nil
+	}
+
+	return nil, err
+}
+
+// Load returns the (possibly cached) project configuration applying to the
+// directory.  An empty configuration is returned if no file is found.
+func Load(dir string) (*Config, error) {
+	var (
+		absDir string
+		path   string
+		cfg    *Config
+		ok     bool
+		err    error
+	)
+
+	absDir, err = filepath.Abs(dir)
+
+	if err == nil {
+		cfg, ok = configCache[absDir]
+		if ok {
+			return cfg, nil
+		}
+
+		path, err = find(absDir)
+	}
+
+	if err == nil && path == "" {
+		cfg = new(Config)
+	}
+
+	if err == nil && path != "" {
+		szlog.Say1("Loading configuration: ", path, "\n")
+
+		cfg, err = parse(path)
+	}
+
+	if err == nil {
+		configCache[absDir] = cfg
+
+		return cfg, nil
+	}
+
+	return nil, err
+}
@@ -0,0 +1,155 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Config_NoFile(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer config.Reset()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+
+	cfg, err := config.Load(tstDir)
+	chk.NoErr(err)
+	chk.Str(cfg.Path(), "")
+	chk.False(cfg.ExecAllowed("make"))
+	chk.True(cfg.BuiltinRules())
+}
+
+func Test_Config_FoundInParent(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer config.Reset()
+
+	tstDir := chk.CreateTmpDir()
+	subDir := chk.CreateTmpSubDir("docs", "examples")
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+	cfgPath := chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"allow": ["make", "./run.sh"]}}`),
+	)
+
+	cfg, err := config.Load(subDir)
+	chk.NoErr(err)
+	chk.Str(cfg.Path(), cfgPath)
+	chk.True(cfg.ExecAllowed("make"))
+	chk.True(cfg.ExecAllowed("./run.sh"))
+	chk.False(cfg.ExecAllowed("run.sh"))
+	chk.False(cfg.ExecAllowed("rm"))
+	chk.True(cfg.ExecAllowed("./docs/../run.sh"))
+	chk.True(cfg.ExecAllowedIn("make", "docs"))
+	chk.True(cfg.ExecAllowedIn("../run.sh", "docs"))
+	chk.False(cfg.ExecAllowedIn("./run.sh", "docs"))
+
+	// Cached until reset.
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"allow": []}}`),
+	)
+
+	cfg, err = config.Load(subDir)
+	chk.NoErr(err)
+	chk.True(cfg.ExecAllowed("make"))
+
+	config.Reset()
+
+	cfg, err = config.Load(subDir)
+	chk.NoErr(err)
+	chk.False(cfg.ExecAllowed("make"))
+}
+
+func Test_Config_StopsAtModuleRoot(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer config.Reset()
+
+	tstDir := chk.CreateTmpDir()
+	modDir := chk.CreateTmpSubDir("module")
+	_ = chk.CreateTmpFileAs(modDir, "go.mod", []byte("module example\n"))
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"allow": ["make"]}}`),
+	)
+
+	cfg, err := config.Load(modDir)
+	chk.NoErr(err)
+	chk.Str(cfg.Path(), "")
+	chk.False(cfg.ExecAllowed("make"))
+}
+
+func Test_Config_Normalize(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer config.Reset()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName, []byte(`{
+		"normalize": {
+			"builtin": false,
+			"rules": [{"match": "v\\d+", "replace": "vN"}]
+		}
+	}`))
+
+	cfg, err := config.Load(tstDir)
+	chk.NoErr(err)
+	chk.False(cfg.BuiltinRules())
+	chk.Int(len(cfg.Normalize.Rules), 1)
+	chk.Str(cfg.Normalize.Rules[0].Match, `v\d+`)
+	chk.Str(cfg.Normalize.Rules[0].Replace, "vN")
+}
+
+func Test_Config_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer config.Reset()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+	cfgPath := chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"permit": ["make"]}}`),
+	)
+
+	cfg, err := config.Load(tstDir)
+	chk.Err(err,
+		errs.ErrInvalidConfig.Error()+": "+cfgPath+
+			": json: unknown field \"permit\"",
+	)
+	chk.Nil(cfg)
+
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName, []byte(`{"exec": `))
+
+	_, err = config.Load(filepath.Join(tstDir, "."))
+	chk.Err(err,
+		errs.ErrInvalidConfig.Error()+": "+cfgPath+
+			": unexpected EOF",
+	)
+}
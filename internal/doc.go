@@ -78,19 +78,129 @@ const DirectiveHowTo = "" +
 	"" + "\n" +
 	"Available actions are:" + "\n" +
 	"" + "\n" +
+	"   - `apidiff` records the exported API in a snapshot reporting any changes" + "\n" +
+	"   - `badge` writes a locally computed SVG status badge and links to it" + "\n" +
+	"   - `bench` runs benchmarks and tabulates (or compares) their results" + "\n" +
+	"   - `callgraph` renders the functions called by (or calling) a function" + "\n" +
+	"   - `coverage` runs the tests and tabulates the coverage of each function" + "\n" +
 	"   - `doc`   runs and embeds output from `go doc` for a package object" + "\n" +
 	"   - `dcl`   inserts the declaration of package objects" + "\n" +
 	"   - `dclg`  inserts the declaration group for package objects (IE `const` blocks)" + "\n" +
 	"   - `dcln`  inserts the declaration exactly as defined in source including comments" + "\n" +
 	"   - `dcls`  inserts the declaration formatted as a single line" + "\n" +
+	"   - `deprecated` renders a migration table of deprecated identifiers" + "\n" +
+	"   - `deps`  renders the package import graph as a Mermaid (or DOT) diagram" + "\n" +
+	"   - `doccov` reports exported identifiers missing conventional doc comments" + "\n" +
+	"   - `exec`  runs an allow-listed command and frames its output" + "\n" +
+	"   - `file`  includes a text file of any type fenced with its inferred language" + "\n" +
+	"   - `func`  inserts the complete source of a function or method" + "\n" +
+	"   - `fuzz`  tabulates a fuzz target's seed and recorded corpus (optionally fuzzing it)" + "\n" +
 	"   - `irun`  runs the package and inserts the output without decorations" + "\n" +
 	"   - `run`   runs the package and frames the output with the command executed" + "\n" +
+	"   - `session` runs several commands in a scratch directory as a terminal transcript" + "\n" +
 	"   - `snip`  includes an external snippet expanding any embedded directives" + "\n" +
 	"   - `src`   includes a Go source file" + "\n" +
 	"   - `tst`   runs a Go test (or all tests) in a package" + "\n" +
 	"   - `tstc`  Runs a go test (or all tests) and converts output to TeX to preserve formatting" + "\n" +
 	"" + "\n" +
-	"### Action: doc" + "\n" +
+	"### Action: apidiff" + "\n" +
+	"" + "\n" +
+	"Records the exported API of the packages in the specified directories into" + "\n" +
+	"a checked-in snapshot file and reports any differences from the API" + "\n" +
+	"previously recorded there.  Additions are compatible while removed or" + "\n" +
+	"changed declarations, and methods added to an existing interface, are" + "\n" +
+	"flagged as `INCOMPATIBLE`." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::apidiff::./directory/. [./other/.] snapshot=./api.txt -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option     | default | description                                     |" + "\n" +
+	"   | ---------- | ------- | ----------------------------------------------- |" + "\n" +
+	"   | `snapshot` |         | required local path of the snapshot file        |" + "\n" +
+	"   | `show`     | `true`  | embed the current API listing in the document   |" + "\n" +
+	"   | `tags`     |         | build tags used when loading the packages       |" + "\n" +
+	"   | `goos`     |         | target operating system                         |" + "\n" +
+	"   | `goarch`   |         | target architecture                             |" + "\n" +
+	"" + "\n" +
+	"The snapshot is updated like any other generated file so running with `-u`" + "\n" +
+	"exits with status 2 whenever an API change has not yet been recorded." + "\n" +
+	"" + "\n" +
+	"### Action: badge" + "\n" +
+	"" + "\n" +
+	"Computes a status badge locally (without any external badge service so it" + "\n" +
+	"works offline and for private repositories), writes it as an SVG file next" + "\n" +
+	"to the generated document and inserts a relative image link to it." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::badge::coverage ./directory/... [option=value ...] -->" + "\n" +
+	"\t<!--- gotomd::badge::tests ./directory/... [option=value ...] -->" + "\n" +
+	"\t<!--- gotomd::badge::go [option=value ...] -->" + "\n" +
+	"\t<!--- gotomd::badge::license [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The following badges are supported:" + "\n" +
+	"" + "\n" +
+	"   | badge      | value                                                     |" + "\n" +
+	"   | ---------- | --------------------------------------------------------- |" + "\n" +
+	"   | `coverage` | total statement coverage of the package (`.`) or packages (`...`) |" + "\n" +
+	"   | `tests`    | number of tests (including subtests) passed or failed     |" + "\n" +
+	"   | `go`       | Go version declared by the module's `go.mod`              |" + "\n" +
+	"   | `license`  | license identified from the module's `LICENSE` file       |" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option       | default           | description                           |" + "\n" +
+	"   | ------------ | ----------------- | ------------------------------------- |" + "\n" +
+	"   | `file`       | `badge-KIND.svg`  | local path of the SVG file written    |" + "\n" +
+	"   | `label`      | badge kind        | text shown on the left of the badge   |" + "\n" +
+	"   | `thresholds` | `50,80`           | coverage below which the badge is red and yellow (otherwise green) |" + "\n" +
+	"   | `timeout`    | `10m`             | maximum run time (see Timeouts)       |" + "\n" +
+	"" + "\n" +
+	"The SVG file is updated like any other generated file so running with `-u`" + "\n" +
+	"exits with status 2 whenever a badge has changed." + "\n" +
+	"" + "\n" +
+	"Statically computes the functions called by (`callees`) or calling" + "\n" +
+	"(`callers`) the named function or method (`Type.Method`) within its module" + "\n" +
+	"and renders the excerpt as a Mermaid flowchart or as an indented list." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::callgraph::./directory/Function [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::callgraph::./directory/Type.Method format=list depth=3 -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option      | default   | description                               |" + "\n" +
+	"   | ----------- | --------- | ----------------------------------------- |" + "\n" +
+	"   | `depth`     | `2`       | number of call levels followed            |" + "\n" +
+	"   | `direction` | `callees` | follow `callees` or `callers`             |" + "\n" +
+	"   | `format`    | `mermaid` | render as a `mermaid` flowchart or `list` |" + "\n" +
+	"" + "\n" +
+	"Only functions belonging to the module are shown.  Recursive calls are" + "\n" +
+	"marked in lists rather than followed." + "\n" +
+	"" + "\n" +
+	"### Action: coverage" + "\n" +
+	"" + "\n" +
+	"Runs the tests of the package in the specified directory (`.`) or of every" + "\n" +
+	"package below it (`...`) with a coverage profile and renders the statement" + "\n" +
+	"coverage of each function, computed the same way as `go tool cover -func`," + "\n" +
+	"as a table ending with the overall total." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::coverage::./directory/... [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option     | default | description                                    |" + "\n" +
+	"   | ---------- | ------- | ---------------------------------------------- |" + "\n" +
+	"   | `sort`     | `file`  | order rows by `file`, `name` or `coverage`     |" + "\n" +
+	"   | `min`      | `0`     | minimum overall coverage percentage required   |" + "\n" +
+	"   | `func-min` | `0`     | minimum coverage required of every function    |" + "\n" +
+	"   | `tags`     |         | build tags used when running the tests         |" + "\n" +
+	"   | `goos`     |         | target operating system                        |" + "\n" +
+	"   | `goarch`   |         | target architecture                            |" + "\n" +
+	"   | `timeout`  | `10m`   | maximum run time (see Timeouts)                |" + "\n" +
+	"" + "\n" +
+	"Functions without statements are ignored by `func-min`.  If any function" + "\n" +
+	"falls below `func-min` it is listed and processing fails with a non-zero" + "\n" +
+	"exit status, as it does when the total falls below `min` or any test fails." + "\n" +
 	"" + "\n" +
 	"Runs `go doc` on the specified object in the given relative package" + "\n" +
 	"directory." + "\n" +
@@ -113,6 +223,9 @@ const DirectiveHowTo = "" +
 	"\t   ./anotherDifferentDirectory/package" + "\n" +
 	"\t-->" + "\n" +
 	"" + "\n" +
+	"Objects whose comment contains a `Deprecated:` paragraph are preceded by" + "\n" +
+	"their struck through name and a **DEPRECATED** badge in markdown output." + "\n" +
+	"" + "\n" +
 	"There are four additional directives all similar to `doc` but focused on object" + "\n" +
 	"declaration formatting as follows:" + "\n" +
 	"" + "\n" +
@@ -125,6 +238,19 @@ const DirectiveHowTo = "" +
 	"" + "\n" +
 	"See individual Action sections for more detail." + "\n" +
 	"" + "\n" +
+	"#### Build constraints" + "\n" +
+	"" + "\n" +
+	"Packages are loaded for the host platform without any build tags unless" + "\n" +
+	"the `--tags`, `--goos` or `--goarch` command line options are given.  Any of" + "\n" +
+	"the `doc` style directives may override these for their objects by adding" + "\n" +
+	"`tags=`, `goos=` or `goarch=` options, so platform specific or tagged" + "\n" +
+	"declarations document the correct variant:" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::doc::./directory/goObject tags=integration goos=windows -->" + "\n" +
+	"" + "\n" +
+	"Tags may be separated by commas.  Each distinct combination is loaded and" + "\n" +
+	"cached separately." + "\n" +
+	"" + "\n" +
 	"### Action: dcl" + "\n" +
 	"" + "\n" +
 	"Similar to the `doc` directive, `dcl` inserts the declaration of the specified" + "\n" +
@@ -174,7 +300,138 @@ const DirectiveHowTo = "" +
 	"\t   ..." + "\n" +
 	"\t-->" + "\n" +
 	"" + "\n" +
-	"### Action: irun" + "\n" +
+	"### Action: deprecated" + "\n" +
+	"" + "\n" +
+	"Renders a migration table listing every exported identifier of the package" + "\n" +
+	"in the specified directory (`.`) or of every package below it (`...`) whose" + "\n" +
+	"comment contains a `Deprecated:` paragraph." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::deprecated::./directory/... -->" + "\n" +
+	"" + "\n" +
+	"Each row contains the identifier, the deprecation note and, when the note" + "\n" +
+	"names an identifier of the same package either as a doc link (`[NewName]`)" + "\n" +
+	"or as an instruction (`Use NewName`), a link to the replacement's" + "\n" +
+	"declaration in its source file (`./directory/file.go#L12`) relative to the" + "\n" +
+	"document, so private and internal modules are linked as well.  The `tags`," + "\n" +
+	"`goos` and `goarch` options are supported as for `doc`." + "\n" +
+	"" + "\n" +
+	"### Action: deps" + "\n" +
+	"" + "\n" +
+	"Renders the import graph of the package in the specified directory (`.`) or" + "\n" +
+	"of every package below it (`...`) as a Mermaid `graph` block which GitHub" + "\n" +
+	"renders natively." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::deps::./directory/... [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option     | default   | description                                    |" + "\n" +
+	"   | ---------- | --------- | ---------------------------------------------- |" + "\n" +
+	"   | `internal` | `false`   | only include packages from the main module(s)  |" + "\n" +
+	"   | `std`      | `true`    | include standard library packages              |" + "\n" +
+	"   | `depth`    | `0`       | maximum import depth followed (`0` = no limit) |" + "\n" +
+	"   | `format`   | `mermaid` | diagram format: `mermaid` or `dot`             |" + "\n" +
+	"" + "\n" +
+	"Packages belonging to the main module are labelled by their path within the" + "\n" +
+	"module." + "\n" +
+	"" + "\n" +
+	"### Action: doccov" + "\n" +
+	"" + "\n" +
+	"Reports the documentation coverage of the package in the specified directory" + "\n" +
+	"(`.`) or of every package below it (`...`) as a table followed by a list of" + "\n" +
+	"exported identifiers without a doc comment or whose comment does not start" + "\n" +
+	"with the identifier's name as Go convention requires." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::doccov::./directory/... [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option    | default | description                                  |" + "\n" +
+	"   | --------- | ------- | -------------------------------------------- |" + "\n" +
+	"   | `min`     | `0`     | minimum overall coverage percentage required |" + "\n" +
+	"   | `details` | `true`  | list the undocumented identifiers            |" + "\n" +
+	"   | `tags`    |         | build tags used when loading the packages    |" + "\n" +
+	"   | `goos`    |         | target operating system                      |" + "\n" +
+	"   | `goarch`  |         | target architecture                          |" + "\n" +
+	"" + "\n" +
+	"If the overall coverage falls below `min` the undocumented identifiers are" + "\n" +
+	"listed and processing fails with a non-zero exit status." + "\n" +
+	"" + "\n" +
+	"### Action: exec" + "\n" +
+	"" + "\n" +
+	"Runs an arbitrary command (`make help`, `./scripts/demo.sh`, ...) and frames" + "\n" +
+	"its combined output with the command executed.  The command is split into" + "\n" +
+	"words using shell-like quoting but is run directly without a shell." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::exec::[option=value ...] program [argument ...] -->" + "\n" +
+	"" + "\n" +
+	"The `cwd`, `env`, `stdin`, `timeout`, `expect-exit`, `show-exit`, `output`" + "\n" +
+	"and `as` options described for `run` may precede the command." + "\n" +
+	"" + "\n" +
+	"For safety the program must be listed in the `exec` allow-list of a" + "\n" +
+	"`.gotomd.json` configuration file found in the template's directory or one" + "\n" +
+	"of its parents up to the module root.  A program given by a path is" + "\n" +
+	"resolved against the `cwd` directory and must match a path listed relative" + "\n" +
+	"to the template's directory:" + "\n" +
+	"" + "\n" +
+	"\t{" + "\n" +
+	"\t  \"exec\": {" + "\n" +
+	"\t    \"allow\": [\"make\", \"./scripts/demo.sh\"]" + "\n" +
+	"\t  }" + "\n" +
+	"\t}" + "\n" +
+	"" + "\n" +
+	"### Action: file" + "\n" +
+	"" + "\n" +
+	"Inserts the contents of the specified file of any type (YAML, JSON, SQL," + "\n" +
+	"shell, proto, Dockerfile, Makefile, ...) fenced with the language inferred" + "\n" +
+	"from its name or extension (`text` if unknown).  Binary files are refused." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::file::./directory/config.yaml [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The same line ranges, regions and options as `src` are supported along with" + "\n" +
+	"`lang` overriding the inferred fence language." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::file::./directory/settings.conf#L1-L12 lang=ini -->" + "\n" +
+	"" + "\n" +
+	"### Action: func" + "\n" +
+	"" + "\n" +
+	"Inserts the complete source (including its body) of the named function or" + "\n" +
+	"method (`Type.Method`) in the given relative package directory.  Additional" + "\n" +
+	"objects may be listed as for `doc`." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::func::./directory/Type.Method [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option  | default | description                                       |" + "\n" +
+	"   | ------- | ------- | ------------------------------------------------- |" + "\n" +
+	"   | `doc`   | `false` | precede the source with its doc comment           |" + "\n" +
+	"   | `elide` | `false` | replace function literal bodies with `{ ... }`    |" + "\n" +
+	"" + "\n" +
+	"The `tags`, `goos` and `goarch` options are supported as for `doc`." + "\n" +
+	"" + "\n" +
+	"### Action: fuzz" + "\n" +
+	"" + "\n" +
+	"Documents the fuzz target in the package directory as a table of its seed" + "\n" +
+	"corpus (the values added with `f.Add`) followed by each file recorded under" + "\n" +
+	"`testdata/fuzz/FuzzX/` with its values decoded.  Columns are named after the" + "\n" +
+	"parameters of the function passed to `f.Fuzz`." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::fuzz::./directory/FuzzX [option=value ...] -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option     | default | description                                      |" + "\n" +
+	"   | ---------- | ------- | ------------------------------------------------ |" + "\n" +
+	"   | `fuzztime` |         | fuzz the target first for a time (`10s`) or count (`1000x`) |" + "\n" +
+	"   | `timeout`  | `10m`   | maximum run time (see Timeouts)                  |" + "\n" +
+	"" + "\n" +
+	"When fuzzed, any failure found is reported after the table and the failing" + "\n" +
+	"input Go records in the corpus is marked as a `(new failure)`.  A run that" + "\n" +
+	"fails without recording an input (a build failure or a failing seed) stops" + "\n" +
+	"processing with a non-zero exit status.  Fuzzed results are bracketed as" + "\n" +
+	"volatile so up to date checks ignore them, and the target is not fuzzed" + "\n" +
+	"when only checking." + "\n" +
 	"" + "\n" +
 	"Runs `go run` on the package in the specified directory (assumes `main`) with" + "\n" +
 	"the provided arguments." + "\n" +
@@ -192,6 +449,141 @@ const DirectiveHowTo = "" +
 	"" + "\n" +
 	"\t<!--- gotomd::run::./directory/. [args ...] -->" + "\n" +
 	"" + "\n" +
+	"Arguments for both `run` and `irun` are split using shell-like quoting" + "\n" +
+	"(single quotes, double quotes and backslash escapes) so they may contain" + "\n" +
+	"spaces, glob characters or even span several lines.  No expansions are" + "\n" +
+	"performed.  The command displayed is re-quoted so that it may be copied and" + "\n" +
+	"run exactly as shown." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::./cmd/tool --name \"hello world\" '*.go' -->" + "\n" +
+	"" + "\n" +
+	"The following options may precede the package for both `run` and `irun`:" + "\n" +
+	"" + "\n" +
+	"   | option        | default    | description                                |" + "\n" +
+	"   | ------------- | ---------- | ------------------------------------------ |" + "\n" +
+	"   | `cwd`         | `.`        | relative directory to run the program in   |" + "\n" +
+	"   | `env`         |            | `KEY=value` added to the environment       |" + "\n" +
+	"   | `stdin`       |            | relative file (or `<<EOF` heredoc) to read |" + "\n" +
+	"   | `timeout`     | `10m`      | maximum run time (see Timeouts)            |" + "\n" +
+	"   | `expect-exit` | `0`        | exit code the program must return          |" + "\n" +
+	"   | `show-exit`   |            | display the exit code (default if not `0`) |" + "\n" +
+	"   | `output`      | `combined` | output streams displayed (see below)       |" + "\n" +
+	"   | `as`          |            | program name displayed instead of `go run` |" + "\n" +
+	"   | `head`        |            | display only the first lines of output     |" + "\n" +
+	"   | `tail`        |            | display only the last lines of output      |" + "\n" +
+	"   | `lines`       |            | display only the range of lines (`5-30`)   |" + "\n" +
+	"   | `grep`        |            | display only lines matching the expression |" + "\n" +
+	"" + "\n" +
+	"The `env` option may be repeated." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::env=NAME=world stdin=./testdata/input.txt ./cmd/tool -->" + "\n" +
+	"" + "\n" +
+	"With `stdin=<<EOF` the program's input is taken from the lines of the" + "\n" +
+	"directive following it up to a line containing only `EOF` (any word may be" + "\n" +
+	"used as the marker).  The lines are used exactly as written including their" + "\n" +
+	"indentation:" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::stdin=<<EOF ./cmd/tool --sort" + "\n" +
+	"\tbanana" + "\n" +
+	"\tapple" + "\n" +
+	"\tEOF" + "\n" +
+	"\t-->" + "\n" +
+	"" + "\n" +
+	"A program exiting with any code other than `expect-exit` stops processing" + "\n" +
+	"with an error showing its output.  When shown the exit code follows the" + "\n" +
+	"output as it would in a shell:" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::expect-exit=2 ./cmd/tool --bad-flag -->" + "\n" +
+	"" + "\n" +
+	"\tunknown flag: --bad-flag" + "\n" +
+	"\t$ echo $?" + "\n" +
+	"\t2" + "\n" +
+	"" + "\n" +
+	"The `output` option selects how the program's standard output and standard" + "\n" +
+	"error are displayed:" + "\n" +
+	"" + "\n" +
+	"   | output     | displays                                                   |" + "\n" +
+	"   | ---------- | ---------------------------------------------------------- |" + "\n" +
+	"   | `combined` | both streams merged as written                             |" + "\n" +
+	"   | `stdout`   | only the standard output                                   |" + "\n" +
+	"   | `stderr`   | only the standard error                                    |" + "\n" +
+	"   | `both`     | each stream in its own block labelled `stdout:`/`stderr:`  |" + "\n" +
+	"   | `marked`   | both streams merged with stderr lines prefixed `[stderr] ` |" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::expect-exit=2 output=stderr ./cmd/tool --bad-flag -->" + "\n" +
+	"" + "\n" +
+	"The command displayed reproduces the options (`cd dir && KEY=value go run" + "\n" +
+	"... < file`) so that it may still be copied and run exactly as shown." + "\n" +
+	"" + "\n" +
+	"The `head`, `tail`, `lines` and `grep` options keep long output readable." + "\n" +
+	"The output is first limited to the `lines` range (`5-30`, `5-` or just `5`)" + "\n" +
+	"then to the lines matching the `grep` regular expression before keeping the" + "\n" +
+	"first `head` and last `tail` lines (both may be given).  Each place lines" + "\n" +
+	"were removed is marked with `...`:" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::head=3 tail=2 ./cmd/tool --list -->" + "\n" +
+	"" + "\n" +
+	"\talpha" + "\n" +
+	"\tbeta" + "\n" +
+	"\tgamma" + "\n" +
+	"\t..." + "\n" +
+	"\tomega" + "\n" +
+	"" + "\n" +
+	"Each package is built only once (into a temporary directory removed when" + "\n" +
+	"gotomd finishes) no matter how many `run` and `irun` directives use it.  The" + "\n" +
+	"binary is then executed directly so compiler errors stop processing with an" + "\n" +
+	"error rather than appearing as program output.  The `as` option displays the" + "\n" +
+	"command as the installed program would be invoked:" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::run::as=mytool ./cmd/mytool --help -->" + "\n" +
+	"" + "\n" +
+	"\tmytool --help" + "\n" +
+	"" + "\n" +
+	"### Action: session" + "\n" +
+	"" + "\n" +
+	"Runs a sequence of commands (steps) in a new scratch directory (removed" + "\n" +
+	"afterwards) and renders a single transcript of the commands and their output" + "\n" +
+	"as they would appear in a terminal.  Each step sees the files left by the" + "\n" +
+	"steps before it so tutorials may show stateful, multi-command walkthroughs." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::session::[option=value ...]" + "\n" +
+	"\t$ [option=value ...] program [argument ...]" + "\n" +
+	"\t! [option=value ...] program [argument ...]" + "\n" +
+	"\t-->" + "\n" +
+	"" + "\n" +
+	"Steps starting with `$ ` are shown with a prompt followed by their output." + "\n" +
+	"Steps starting with `! ` are hidden setup steps: they are run (and must" + "\n" +
+	"succeed) but appear nowhere in the transcript.  Any other lines continue the" + "\n" +
+	"step above them.  Each step accepts the same options as `run` with `cwd` and" + "\n" +
+	"`stdin` files resolved within the scratch directory." + "\n" +
+	"" + "\n" +
+	"The program is either a relative go package (built once and run directly," + "\n" +
+	"displayed by its binary name unless `as` is given), the built in `write`" + "\n" +
+	"command saving its standard input to a file in the scratch directory" + "\n" +
+	"(displayed as `cat > file`) or any other program allowed by the `exec`" + "\n" +
+	"allow-list." + "\n" +
+	"" + "\n" +
+	"The following options may appear on the directive's first line:" + "\n" +
+	"" + "\n" +
+	"   | option    | description                                               |" + "\n" +
+	"   | --------- | --------------------------------------------------------- |" + "\n" +
+	"   | `copy`    | `src[=dest]` fixture file or directory copied beforehand  |" + "\n" +
+	"   | `timeout` | default maximum run time of each step (see Timeouts)      |" + "\n" +
+	"" + "\n" +
+	"The `copy` option may be repeated.  Sources are relative to the template's" + "\n" +
+	"directory while destinations (defaulting to the source's name) are relative" + "\n" +
+	"to the scratch directory." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::session::copy=./testdata/notes.txt" + "\n" +
+	"\t! ./cmd/notes init" + "\n" +
+	"\t$ ./cmd/notes list" + "\n" +
+	"\t$ stdin=<<EOF write more.txt" + "\n" +
+	"\twater plants" + "\n" +
+	"\tEOF" + "\n" +
+	"\t$ ./cmd/notes import more.txt" + "\n" +
+	"\t$ ./cmd/notes list" + "\n" +
+	"\t-->" + "\n" +
+	"" + "\n" +
 	"### Action: snip" + "\n" +
 	"" + "\n" +
 	"Loads the referenced snippet and expands any embedded directives." + "\n" +
@@ -211,6 +603,29 @@ const DirectiveHowTo = "" +
 	"" + "\n" +
 	"\t<!--- gotomd::src::./directory/fileName.go -->" + "\n" +
 	"" + "\n" +
+	"Only part of the file is included when the file name is followed by either a" + "\n" +
+	"line range (`#L10-L42` or just `#L10`) or the name of a region." + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::src::./directory/fileName.go#L10-L42 -->" + "\n" +
+	"" + "\n" +
+	"Regions are delimited by comments containing the markers" + "\n" +
+	"`gotomd:region name` and `gotomd:endregion`.  Regions may be nested." + "\n" +
+	"" + "\n" +
+	"\t// gotomd:region setup" + "\n" +
+	"\tfunc setup() {" + "\n" +
+	"\t    ..." + "\n" +
+	"\t}" + "\n" +
+	"\t// gotomd:endregion" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::src::./directory/fileName.go#setup strip=true dedent=true -->" + "\n" +
+	"" + "\n" +
+	"The following options are supported:" + "\n" +
+	"" + "\n" +
+	"   | option   | default | description                                 |" + "\n" +
+	"   | -------- | ------- | ------------------------------------------- |" + "\n" +
+	"   | `strip`  | `false` | remove any region marker lines included     |" + "\n" +
+	"   | `dedent` | `false` | remove the indentation common to all lines  |" + "\n" +
+	"" + "\n" +
 	"### Action: tst" + "\n" +
 	"" + "\n" +
 	"Runs the specified Go test." + "\n" +
@@ -221,6 +636,31 @@ const DirectiveHowTo = "" +
 	"" + "\n" +
 	"\t<!--- gotomd::tst::./directory/. -->" + "\n" +
 	"" + "\n" +
+	"A `timeout=` option (see Timeouts) is supported by both `tst` and `tstc`:" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::tst::./directory/. timeout=2m -->" + "\n" +
+	"" + "\n" +
+	"The `head`, `tail`, `lines` and `grep` options described for `run` are also" + "\n" +
+	"supported by both `tst` and `tstc` (with no spaces in the `grep` expression):" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::tst::./directory/. grep=^(---|ok|FAIL) -->" + "\n" +
+	"" + "\n" +
+	"Instead of the verbose text the `report` option runs `go test -json` and" + "\n" +
+	"renders structured results.  Its value is a comma separated list of the" + "\n" +
+	"renderings wanted (in order):" + "\n" +
+	"" + "\n" +
+	"   | report    | renders                                                       |" + "\n" +
+	"   | --------- | ------------------------------------------------------------- |" + "\n" +
+	"   | `table`   | a table of each test with its status and subtest counts       |" + "\n" +
+	"   | `details` | a collapsed `<details>` block with the output of each failure |" + "\n" +
+	"   | `summary` | the overall status and the tests passed, failed and skipped   |" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::tst::./directory/. report=summary,table,details -->" + "\n" +
+	"" + "\n" +
+	"Tests are listed in the order they started and each test's output is kept" + "\n" +
+	"together so the report does not depend on timing or on how parallel tests" + "\n" +
+	"interleave.  A package that fails to build is reported as an error." + "\n" +
+	"" + "\n" +
 	"### Action: tstc" + "\n" +
 	"" + "\n" +
 	"Runs the specified Go test." + "\n" +
@@ -233,4 +673,62 @@ const DirectiveHowTo = "" +
 	"\t<!--- gotomd::tstc::./directory/testName -->" + "\n" +
 	"" + "\n" +
 	"\t<!--- gotomd::tstc::./directory/. -->" + "\n" +
+	"" + "\n" +
+	"## Timeouts" + "\n" +
+	"" + "\n" +
+	"Every command run (`run`, `irun`, `exec`, `session`, `tst` and `tstc`) and" + "\n" +
+	"every package" + "\n" +
+	"load is limited to the time given by the `--timeout` argument (default `10m`)" + "\n" +
+	"which the command directives may override with a `timeout=` option written" + "\n" +
+	"either as a Go duration (`90s`, `2m30s`) or a number of seconds.  When the" + "\n" +
+	"limit is reached the command along with every process it started is killed" + "\n" +
+	"and processing stops with an error naming the directive and including any" + "\n" +
+	"output collected so far.  Interrupting gotomd (Ctrl-C) likewise stops all" + "\n" +
+	"running commands." + "\n" +
+	"" + "\n" +
+	"## Normalization" + "\n" +
+	"" + "\n" +
+	"The output of every command run (`run`, `irun`, `exec`, `session`, `tst` and" + "\n" +
+	"`tstc`) is normalized before being embedded so that nondeterministic noise" + "\n" +
+	"does not cause spurious differences.  The following built in rules are" + "\n" +
+	"applied:" + "\n" +
+	"" + "\n" +
+	"   | noise                                          | replaced with     |" + "\n" +
+	"   | ---------------------------------------------- | ----------------- |" + "\n" +
+	"   | timestamps (`2024-03-01T12:34:56Z`)            | `<timestamp>`     |" + "\n" +
+	"   | fractional durations (`1.234s`, `12.5ms`)      | `0.0s`, `0.0ms`   |" + "\n" +
+	"   | process ids (`pid 1234`, `PID: 1234`)          | `pid <pid>`       |" + "\n" +
+	"   | random temporary paths (`/tmp/go-build123456`) | `$TMPDIR`         |" + "\n" +
+	"   | the user's home directory                      | `~`               |" + "\n" +
+	"   | the host name (whole words, not `localhost`)   | `<hostname>`      |" + "\n" +
+	"" + "\n" +
+	"Additional rules (a regular expression applied to each line and its" + "\n" +
+	"replacement which may refer to submatches as `${1}`) are applied after the" + "\n" +
+	"built in ones which may be disabled.  Both are configured in the" + "\n" +
+	"`.gotomd.json` configuration file:" + "\n" +
+	"" + "\n" +
+	"\t{" + "\n" +
+	"\t  \"normalize\": {" + "\n" +
+	"\t    \"builtin\": true," + "\n" +
+	"\t    \"rules\": [" + "\n" +
+	"\t      {\"match\": \"v(\\\\d+)\\\\.\\\\d+\\\\.\\\\d+\", \"replace\": \"v${1}.x.y\"}," + "\n" +
+	"\t      {\"match\": \"token=\\\\S+\", \"replace\": \"token=REDACTED\"}" + "\n" +
+	"\t    ]" + "\n" +
+	"\t  }" + "\n" +
+	"\t}" + "\n" +
+	"" + "\n" +
+	"## Workspaces" + "\n" +
+	"" + "\n" +
+	"When the template directory belongs to a `go.work` workspace, directive" + "\n" +
+	"directories may also be written relative to the template using `../` as long" + "\n" +
+	"as they remain within the workspace root or one of the modules it uses" + "\n" +
+	"(including modules outside the root such as `use ../sibling`):" + "\n" +
+	"" + "\n" +
+	"\t<!--- gotomd::doc::../otherModule/pkg/goObject -->" + "\n" +
+	"" + "\n" +
+	"Packages are always loaded, run and tested from the module that owns them" + "\n" +
+	"with the workspace file explicitly selected, so path resolution does not" + "\n" +
+	"depend on the template's directory.  A `./...` search starting in a directory" + "\n" +
+	"containing a `go.work` file also follows every module it uses, including" + "\n" +
+	"modules located outside that directory." + "\n" +
 	""
@@ -22,24 +22,51 @@ import "errors"
 
 // Exported errors.
 var (
-	ErrInvalidDefPerm     = errors.New("invalid default perm")
-	ErrInvalidOutputDir   = errors.New("invalid output directory")
-	ErrInvalidTemplate    = errors.New("invalid template")
-	ErrInvalidArgument    = errors.New("invalid argument")
-	ErrUnknownObject      = errors.New("unknown package object")
-	ErrInvalidPackage     = errors.New("invalid package")
-	ErrInvalidRelativeDir = errors.New("invalid relative directory")
-	ErrNotLocalDir        = errors.New("not local directory")
-	ErrInvalidDirectory   = errors.New("invalid directory")
-	ErrMissingAction      = errors.New("missing action")
-	ErrNoTestToRun        = errors.New("no tests to run")
-	ErrNoPackageToRun     = errors.New("no package to run")
-	ErrUnknownCommand     = errors.New("unknown command")
-	ErrBlockNotTerminated = errors.New("block not terminated")
-	ErrUnknownTag         = errors.New("unknown tag")
-	ErrUnknownTemplate    = errors.New("unknown template")
-	ErrUpToDateWithForce  = errors.New("uptodate incompatible with force")
-	ErrUpToDateWithPerm   = errors.New("uptodate incompatible with perm")
-	ErrUpToDateWithOutput = errors.New("uptodate incompatible with output")
-	ErrParseError         = errors.New("parse error")
+	ErrInvalidDefPerm      = errors.New("invalid default perm")
+	ErrInvalidOutputDir    = errors.New("invalid output directory")
+	ErrInvalidTemplate     = errors.New("invalid template")
+	ErrInvalidArgument     = errors.New("invalid argument")
+	ErrUnknownObject       = errors.New("unknown package object")
+	ErrInvalidPackage      = errors.New("invalid package")
+	ErrInvalidRelativeDir  = errors.New("invalid relative directory")
+	ErrNotLocalDir         = errors.New("not local directory")
+	ErrInvalidDirectory    = errors.New("invalid directory")
+	ErrMissingAction       = errors.New("missing action")
+	ErrNoTestToRun         = errors.New("no tests to run")
+	ErrNoPackageToRun      = errors.New("no package to run")
+	ErrUnknownCommand      = errors.New("unknown command")
+	ErrBlockNotTerminated  = errors.New("block not terminated")
+	ErrUnknownTag          = errors.New("unknown tag")
+	ErrUnknownTemplate     = errors.New("unknown template")
+	ErrUpToDateWithForce   = errors.New("uptodate incompatible with force")
+	ErrUpToDateWithPerm    = errors.New("uptodate incompatible with perm")
+	ErrUpToDateWithOutput  = errors.New("uptodate incompatible with output")
+	ErrParseError          = errors.New("parse error")
+	ErrInvalidWorkspace    = errors.New("invalid workspace")
+	ErrOutsideWorkspace    = errors.New("outside workspace")
+	ErrInvalidOption       = errors.New("invalid option")
+	ErrDuplicateOption     = errors.New("duplicate option")
+	ErrMissingOption       = errors.New("missing option")
+	ErrInvalidSnapshot     = errors.New("invalid snapshot")
+	ErrDocCoverage         = errors.New("doc coverage below minimum")
+	ErrInvalidRange        = errors.New("invalid line range")
+	ErrUnknownRegion       = errors.New("unknown region")
+	ErrBinaryFile          = errors.New("binary file")
+	ErrUnknownFunction     = errors.New("unknown function")
+	ErrUnterminatedQuote   = errors.New("unterminated quote")
+	ErrInvalidConfig       = errors.New("invalid configuration")
+	ErrExecNotAllowed      = errors.New("exec not allowed")
+	ErrCommandTimeout      = errors.New("command timed out")
+	ErrInterrupted         = errors.New("interrupted")
+	ErrInvalidTimeout      = errors.New("invalid timeout")
+	ErrUnterminatedHeredoc = errors.New("unterminated heredoc")
+	ErrUnexpectedExit      = errors.New("unexpected exit status")
+	ErrBuildFailed         = errors.New("build failed")
+	ErrInvalidStep         = errors.New("invalid session step")
+	ErrTestsFailed         = errors.New("tests failed")
+	ErrCoverage            = errors.New("coverage below minimum")
+	ErrUnknownBadge        = errors.New("unknown badge")
+	ErrNoModule            = errors.New("not within a module")
+	ErrNoBenchToRun        = errors.New("no benchmarks to run")
+	ErrInvalidCorpus       = errors.New("invalid fuzz corpus entry")
 )
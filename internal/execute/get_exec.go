@@ -0,0 +1,123 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package execute
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
+)
+
+type request struct {
+	words []string
+	opts  *cmds.RunOptions
+}
+
+// run executes the request returning its output and exit code.
+func (r *request) run() ([]cmds.Line, int, error) {
+	var (
+		exitErr *exec.ExitError
+		code    int
+	)
+
+	c := proc.Command(r.opts.Timeout, r.words[0], r.words[1:]...)
+	c.Dir = r.opts.Dir
+	c.Env = append(os.Environ(), r.opts.Env...)
+	c.Stdin = bytes.NewReader(r.opts.Stdin)
+
+	lines, err := c.Lines()
+
+	if errors.As(err, &exitErr) {
+		code, err = exitErr.ExitCode(), nil
+	}
+
+	if err == nil {
+		lines, err = normalize.Lines(lines)
+	}
+
+	if err == nil {
+		return lines, code, nil
+	}
+
+	return nil, 0, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// GetExec runs an arbitrary command collecting and returning its formatted
+// output.  The program must be listed in the project configuration's exec
+// allow-list.
+func GetExec(cmd string) (string, error) {
+	var (
+		req     *request
+		cfg     *config.Config
+		cmdLine string
+		lines   []cmds.Line
+		out     string
+		code    int
+		err     error
+	)
+
+	req = new(request)
+
+	req.words, req.opts, err = cmds.ParseRunCmd(cmd, proc.Timeout())
+
+	if err == nil && len(req.words) == 0 {
+		err = errs.ErrMissingAction
+	}
+
+	if err == nil {
+		cfg, err = config.Load(".")
+	}
+
+	if err == nil && !cfg.ExecAllowedIn(req.words[0], req.opts.Dir) {
+		err = fmt.Errorf("%w: %q (add it to exec.allow in %s)",
+			errs.ErrExecNotAllowed, req.words[0], config.FileName,
+		)
+	}
+
+	if err == nil {
+		cmdLine = req.opts.Command(
+			append(req.opts.Program(req.words[0]), req.words[1:]...),
+		)
+		lines, code, err = req.run()
+	}
+
+	if err == nil {
+		out, err = req.opts.Result(cmdLine, lines, code)
+	}
+
+	if err == nil {
+		return format.HLine() + "\n" +
+				format.Inline("bash", cmdLine) + "\n" +
+				"\n" +
+				out + "\n" +
+				format.HLine(),
+			nil
+	}
+
+	return "", err
+}
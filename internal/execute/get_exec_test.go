@@ -0,0 +1,211 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package execute_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/execute"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupExec(t *testing.T, chk *sztest.Chk, allow string) string {
+	t.Helper()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"allow": [`+allow+`]}}`),
+	)
+
+	t.Chdir(tstDir)
+	config.Reset()
+	chk.PushPostReleaseFunc(func() error {
+		config.Reset()
+
+		return nil
+	})
+
+	return tstDir
+}
+
+func Test_GetExec_NotAllowed(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_ = setupExec(t, chk, `"echo"`)
+
+	out, err := execute.GetExec("rm -rf x")
+	chk.Err(err,
+		errs.ErrExecNotAllowed.Error()+
+			`: "rm" (add it to exec.allow in .gotomd.json)`,
+	)
+	chk.Str(out, "")
+}
+
+func Test_GetExec_RelativeProgram(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := setupExec(t, chk, `"./run.sh"`)
+	subDir := chk.CreateTmpSubDir("sub")
+
+	for _, dir := range []string{tstDir, subDir} {
+		chk.NoErr(os.WriteFile( //nolint:gosec // Must be executable.
+			filepath.Join(dir, "run.sh"),
+			[]byte("#!/bin/sh\necho "+filepath.Base(dir)+"\n"),
+			0o700,
+		))
+	}
+
+	out, err := execute.GetExec("./run.sh")
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		format.HLine()+"\n"+
+		format.Inline("bash", "./run.sh")+"\n"+
+		"\n"+
+		format.Inline("", filepath.Base(tstDir))+"\n"+
+		format.HLine(),
+	)
+
+	// The same relative path names a different program in another directory.
+	out, err = execute.GetExec("cwd=sub ./run.sh")
+	chk.Err(err,
+		errs.ErrExecNotAllowed.Error()+
+			`: "./run.sh" (add it to exec.allow in .gotomd.json)`,
+	)
+	chk.Str(out, "")
+}
+
+func Test_GetExec_InvalidOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_ = setupExec(t, chk, `"echo"`)
+
+	_, err := execute.GetExec("")
+	chk.Err(err, errs.ErrMissingAction.Error())
+
+	_, err = execute.GetExec("timeout=5s")
+	chk.Err(err, errs.ErrMissingAction.Error())
+
+	_, err = execute.GetExec("echo 'open")
+	chk.Err(err, errs.ErrUnterminatedQuote.Error()+": echo 'open")
+
+	_, err = execute.GetExec("cwd=. cwd=. echo")
+	chk.Err(err, errs.ErrDuplicateOption.Error()+`: "cwd"`)
+
+	_, err = execute.GetExec("cwd=missing echo")
+	chk.Err(err, errs.ErrInvalidDirectory.Error()+`: "missing"`)
+
+	_, err = execute.GetExec("cwd=../x echo")
+	chk.Err(err, errs.ErrNotLocalDir.Error()+`: "../x"`)
+
+	_, err = execute.GetExec("env=NOVALUE echo")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: env="NOVALUE"`)
+
+	_, err = execute.GetExec("timeout=soon echo")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: timeout="soon"`)
+}
+
+func Test_GetExec_Run(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := setupExec(t, chk, `"echo", "sh", "pwd"`)
+	_ = chk.CreateTmpSubDir("sub")
+
+	out, err := execute.GetExec(`echo "hello  world" it\'s`)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		format.HLine()+"\n"+
+		format.Inline("bash", `echo 'hello  world' 'it'\''s'`)+"\n"+
+		"\n"+
+		format.Inline("", "hello  world it's")+"\n"+
+		format.HLine(),
+	)
+
+	out, err = execute.GetExec(`echo took 1.25s`)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		format.HLine()+"\n"+
+		format.Inline("bash", `echo took 1.25s`)+"\n"+
+		"\n"+
+		format.Inline("", "took 0.0s")+"\n"+
+		format.HLine(),
+	)
+
+	out, err = execute.GetExec(
+		`env=GREETING=hi env=NAME=there sh -c 'echo $GREETING $NAME'`,
+	)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		format.HLine()+"\n"+
+		format.Inline("bash",
+			`GREETING=hi NAME=there sh -c 'echo $GREETING $NAME'`,
+		)+"\n"+
+		"\n"+
+		format.Inline("", "hi there")+"\n"+
+		format.HLine(),
+	)
+
+	out, err = execute.GetExec("cwd=sub pwd")
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		format.HLine()+"\n"+
+		format.Inline("bash", "cd sub && pwd")+"\n"+
+		"\n"+
+		format.Inline("", tstDir+"/sub")+"\n"+
+		format.HLine(),
+	)
+
+	out, err = execute.GetExec(`expect-exit=3 sh -c 'echo failing; exit 3'`)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		format.HLine()+"\n"+
+		format.Inline("bash", `sh -c 'echo failing; exit 3'`)+"\n"+
+		"\n"+
+		format.Inline("", "failing\n$ echo $?\n3")+"\n"+
+		format.HLine(),
+	)
+
+	_, err = execute.GetExec(`sh -c 'echo failing; exit 3'`)
+	chk.Err(err, ""+
+		errs.ErrUnexpectedExit.Error()+
+		`: 3 (expected 0): sh -c 'echo failing; exit 3'`+"\n"+
+		"failing",
+	)
+}
+
+func Test_GetExec_Timeout(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_ = setupExec(t, chk, `"sleep"`)
+
+	out, err := execute.GetExec("timeout=100ms sleep 5")
+	chk.Err(err, errs.ErrCommandTimeout.Error()+": after 100ms: sleep 5")
+	chk.Str(out, "")
+}
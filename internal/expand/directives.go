@@ -24,11 +24,22 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dancsecs/gotomd/internal/badge"
 	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/execute"
 	"github.com/dancsecs/gotomd/internal/file"
+	"github.com/dancsecs/gotomd/internal/goapi"
+	"github.com/dancsecs/gotomd/internal/gobench"
+	"github.com/dancsecs/gotomd/internal/gocall"
+	"github.com/dancsecs/gotomd/internal/gocover"
+	"github.com/dancsecs/gotomd/internal/godeprecated"
+	"github.com/dancsecs/gotomd/internal/godeps"
 	"github.com/dancsecs/gotomd/internal/godoc"
+	"github.com/dancsecs/gotomd/internal/godoccov"
+	"github.com/dancsecs/gotomd/internal/gofuzz"
 	"github.com/dancsecs/gotomd/internal/gorun"
 	"github.com/dancsecs/gotomd/internal/gotest"
+	"github.com/dancsecs/gotomd/internal/session"
 )
 
 type commandAction struct {
@@ -77,13 +88,26 @@ var action = new(commandAction)
 //nolint:goCheckNoInits // Ok.
 func init() {
 	action.add("doc::", godoc.GetDoc)
+	action.add("apidiff::", goapi.GetAPIDiff)
+	action.add("badge::", badge.GetBadge)
+	action.add("bench::", gobench.GetBench)
+	action.add("callgraph::", gocall.GetCallGraph)
 	action.add("dcl::", godoc.GetDocDecl)
 	action.add("dclg::", godoc.GetDocDeclConstantBlock)
 	action.add("dcln::", godoc.GetDocDeclNatural)
 	action.add("dcls::", godoc.GetDocDeclSingle)
+	action.add("coverage::", gocover.GetCoverage)
+	action.add("deps::", godeps.GetDeps)
+	action.add("deprecated::", godeprecated.GetDeprecated)
+	action.add("doccov::", godoccov.GetDocCoverage)
 	action.add("src::", file.GetGoFile)
+	action.add("exec::", execute.GetExec)
+	action.add("file::", file.GetFile)
+	action.add("func::", godoc.GetFunc)
+	action.add("fuzz::", gofuzz.GetFuzz)
 	action.add("run::", gorun.GetGoRun)
 	action.add("irun::", gorun.RawGoRun)
+	action.add("session::", session.GetSession)
 	action.add("tst::", gotest.GetGoTst)
 	action.add("tstc::", gotest.GetGoTstColorize)
 	action.add("snip::", includeSnip)
@@ -109,11 +133,13 @@ func includeSnip(cmd string) (string, error) {
 	if err == nil {
 		const expectedArgCount = 2
 
+		// The directive block may span several lines.
+		action = strings.ReplaceAll(action, "\n", " ")
 		cmdArgs = strings.SplitN(action, " ", expectedArgCount)
 		fName = cmdArgs[0]
 
 		if len(cmdArgs) > 1 {
-			startAfter = cmdArgs[1]
+			startAfter = strings.TrimLeft(cmdArgs[1], " ")
 		}
 
 		if startAfter == "string" {
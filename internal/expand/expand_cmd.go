@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/proc"
 )
 
 func isCmd(origLine string) (int, int, error) {
@@ -66,12 +67,21 @@ func expandCmd(
 		err error
 	)
 
-	i, cmd, err = getBlock(i, cmdStart, lines, true, "-->", " ->", " ")
+	i, cmd, err = getBlock(i, cmdStart, lines, false, "-->", " ->", "\n")
 
 	if err == nil {
 		res, err = action.run(cmdIdx, cmd)
 	}
 
+	if proc.Stopped(err) {
+		// Identify which directive timed out or was interrupted.
+		err = fmt.Errorf("%s%s: %w",
+			action.cmdPrefix[cmdIdx],
+			strings.Join(strings.Fields(cmd), " "),
+			err,
+		)
+	}
+
 	if err == nil {
 		return res, i, nil
 	}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/dancsecs/gotomd/internal/format"
 	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/gorun"
 	"github.com/dancsecs/sztestlog"
 )
 
@@ -31,6 +32,8 @@ func TestInternalExpand_ExpandCmd_InlineRun_ForGoDoc(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
+	defer gorun.Reset()
+
 	gopkg.Reset()
 	format.ForGoDoc()
 
@@ -65,6 +68,8 @@ func TestInternalExpand_ExpandCmd_InlineRun_ForMarkdown(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
+	defer gorun.Reset()
+
 	gopkg.Reset()
 	format.ForMarkdown()
 
@@ -581,6 +586,8 @@ func TestInternalExpand_ExpandCmd_Run_ForGoDoc(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
+	defer gorun.Reset()
+
 	gopkg.Reset()
 	format.ForGoDoc()
 
@@ -614,6 +621,8 @@ func TestInternalExpand_ExpandCmd_Run_ForMarkdown(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
+	defer gorun.Reset()
+
 	gopkg.Reset()
 	format.ForMarkdown()
 
@@ -651,6 +660,8 @@ func TestInternalExpand_ExpandCmd_Irun_ForGoDoc(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
+	defer gorun.Reset()
+
 	gopkg.Reset()
 	format.ForGoDoc()
 
@@ -680,6 +691,8 @@ func TestInternalExpand_ExpandCmd_Irun_ForMarkdown(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
+	defer gorun.Reset()
+
 	gopkg.Reset()
 	format.ForMarkdown()
 
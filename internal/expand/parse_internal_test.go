@@ -21,6 +21,7 @@ package expand
 import (
 	"testing"
 
+	"github.com/dancsecs/gotomd/internal/config"
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/sztestlog"
 )
@@ -124,3 +125,39 @@ func TestInternalExpand_Parse_SplitDir(t *testing.T) {
 		chk.Str(path, "", "Idx: ", i, " path")
 	}
 }
+
+func TestInternalExpand_Parse_CommandTimeout(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"allow": ["sh"]}}`),
+	)
+	fName := chk.CreateTmpFileAs(tstDir, "file.md",
+		[]byte(""+
+			szCmdPrefix+"exec::timeout=100ms\n"+
+			"  sh -c 'echo started; sleep 5' -->\n"+
+			"",
+		),
+	)
+
+	t.Chdir(tstDir)
+	config.Reset()
+
+	defer config.Reset()
+
+	updatedDoc, err := parse(fName, "")
+
+	chk.Err(
+		err,
+		errs.ErrParseError.Error()+": "+
+			"exec::timeout=100ms sh -c 'echo started; sleep 5': "+
+			errs.ErrCommandTimeout.Error()+": after 100ms: "+
+			"sh -c 'echo started; sleep 5'\n"+
+			"partial output:\n"+
+			"started",
+	)
+	chk.Str(updatedDoc, "")
+}
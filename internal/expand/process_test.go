@@ -28,6 +28,7 @@ import (
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/gotomd/internal/expand"
 	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/gorun"
 	"github.com/dancsecs/szlog"
 	"github.com/dancsecs/sztest"
 	"github.com/dancsecs/sztestlog"
@@ -99,6 +100,7 @@ func setupTest(
 	if chk.NoErr(err) {
 		chk.PushPostReleaseFunc(func() error {
 			args.Reset()
+			gorun.Reset()
 			szlog.SetVerbose(origVerboseLevel)
 
 			return os.Chdir(origCWD)
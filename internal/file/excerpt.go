@@ -0,0 +1,176 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package file
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// Region markers may appear in any style of comment.
+var (
+	regionStartRe = regexp.MustCompile(`gotomd:region\s+(\S+)`)
+	regionEndRe   = regexp.MustCompile(`gotomd:endregion\b`)
+	lineRangeRe   = regexp.MustCompile(`^L(\d+)(?:-L(\d+))?$`)
+)
+
+// excerpt identifies the portion of a file to include: either an inclusive
+// range of line numbers (starting at 1) or a named region.
+type excerpt struct {
+	from, to int
+	region   string
+}
+
+// parseExcerpt parses the selector following a '#' in a file name: either a
+// line range ("L10-L42" or "L10") or the name of a region.
+func parseExcerpt(selector string) (excerpt, error) {
+	var (
+		e   excerpt
+		err error
+	)
+
+	match := lineRangeRe.FindStringSubmatch(selector)
+	if match == nil {
+		e.region = selector
+
+		return e, nil
+	}
+
+	e.from, err = strconv.Atoi(match[1])
+	e.to = e.from
+
+	if err == nil && match[2] != "" {
+		e.to, err = strconv.Atoi(match[2])
+	}
+
+	if err == nil && (e.from < 1 || e.to < e.from) {
+		err = fmt.Errorf("%w: %q", errs.ErrInvalidRange, selector)
+	}
+
+	return e, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// command describes the excerpt as a shell command displaying it.
+func (e excerpt) command(fPath string) string {
+	if e.region != "" {
+		return catCmd + fPath + "  # region " + e.region
+	}
+
+	return fmt.Sprintf("sed -n '%d,%dp' %s", e.from, e.to, fPath)
+}
+
+func (e excerpt) lineRange(lines []string) ([]string, error) {
+	if e.to > len(lines) {
+		return nil, fmt.Errorf("%w: L%d-L%d: file has %d lines",
+			errs.ErrInvalidRange, e.from, e.to, len(lines),
+		)
+	}
+
+	return lines[e.from-1 : e.to], nil
+}
+
+func (e excerpt) namedRegion(lines []string) ([]string, error) {
+	var (
+		start = -1
+		depth int
+	)
+
+	for i, line := range lines {
+		switch {
+		case start < 0:
+			if m := regionStartRe.FindStringSubmatch(line); m != nil &&
+				m[1] == e.region {
+				start = i + 1
+			}
+		case regionStartRe.MatchString(line):
+			depth++
+		case regionEndRe.MatchString(line) && depth > 0:
+			depth--
+		case regionEndRe.MatchString(line):
+			return lines[start:i], nil
+		}
+	}
+
+	if start < 0 {
+		return nil, fmt.Errorf("%w: %q", errs.ErrUnknownRegion, e.region)
+	}
+
+	return nil, fmt.Errorf("%w: region %q",
+		errs.ErrBlockNotTerminated, e.region,
+	)
+}
+
+// extract returns the selected lines.
+func (e excerpt) extract(lines []string) ([]string, error) {
+	if e.region != "" {
+		return e.namedRegion(lines)
+	}
+
+	return e.lineRange(lines)
+}
+
+// stripMarkers removes all region marker lines.
+func stripMarkers(lines []string) []string {
+	kept := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		if !regionStartRe.MatchString(line) && !regionEndRe.MatchString(line) {
+			kept = append(kept, line)
+		}
+	}
+
+	return kept
+}
+
+// dedent removes the leading whitespace common to all non blank lines.
+func dedent(lines []string) []string {
+	var (
+		prefix string
+		first  = true
+	)
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+
+		if first {
+			prefix, first = indent, false
+
+			continue
+		}
+
+		for !strings.HasPrefix(indent, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	trimmed := make([]string, len(lines))
+	for i, line := range lines {
+		trimmed[i] = strings.TrimPrefix(line, prefix)
+	}
+
+	return trimmed
+}
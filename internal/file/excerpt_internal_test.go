@@ -0,0 +1,85 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package file
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Excerpt_Dedent(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.StrSlice(dedent(nil), []string{})
+	chk.StrSlice(
+		dedent([]string{"\t\ta", "", "\t\t\tb", "\t"}),
+		[]string{"a", "", "\tb", "\t"},
+	)
+	chk.StrSlice(
+		dedent([]string{"    a", "  b", "\tc"}),
+		[]string{"    a", "  b", "\tc"},
+	)
+	chk.StrSlice(
+		dedent([]string{"    a", "  b"}),
+		[]string{"  a", "b"},
+	)
+}
+
+func Test_Excerpt_StripMarkers(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.StrSlice(
+		stripMarkers([]string{
+			"# gotomd:region shell",
+			"echo hello",
+			"<!-- gotomd:endregion -->",
+			"gotomd:regional is not a marker",
+		}),
+		[]string{
+			"echo hello",
+			"gotomd:regional is not a marker",
+		},
+	)
+}
+
+func Test_Excerpt_Parse(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	e, err := parseExcerpt("L3-L7")
+	chk.NoErr(err)
+	chk.Int(e.from, 3)
+	chk.Int(e.to, 7)
+	chk.Str(e.region, "")
+
+	e, err = parseExcerpt("L12")
+	chk.NoErr(err)
+	chk.Int(e.from, 12)
+	chk.Int(e.to, 12)
+
+	e, err = parseExcerpt("L3-7")
+	chk.NoErr(err)
+	chk.Str(e.region, "L3-7")
+
+	_, err = parseExcerpt("L99999999999999999999")
+	chk.NotNil(err)
+}
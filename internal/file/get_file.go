@@ -1,6 +1,6 @@
 /*
    Golang To Github Markdown Utility: gotomd
-   Copyright (C) 2023, 2024 Leslie Dancsecs
+   Copyright (C) 2023, 2024, 2026 Leslie Dancsecs
 
    This program is free software: you can redistribute it and/or modify
    it under the terms of the GNU General Public License as published by
@@ -19,34 +19,119 @@
 package file
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/gotomd/internal/format"
 )
 
 const catCmd = "cat "
 
-// GetGoFile retrieves a go file.
-func GetGoFile(cmd string) (string, error) {
+// Options controlling the included source.
+const (
+	optStrip  = "strip"
+	optDedent = "dedent"
+	optLang   = "lang"
+)
+
+// source reads the file returning the (possibly excerpted) content along
+// with the command displayed as its origin.
+func source(
+	fPath, selector string, strip, unindent bool,
+) (string, string, error) {
+	var (
+		fData   []byte
+		lines   []string
+		command = catCmd + fPath
+		sel     excerpt
+		err     error
+	)
+
+	fData, err = os.ReadFile(fPath) //nolint:gosec // Ok.
+
+	if err == nil && isBinary(fData) {
+		err = fmt.Errorf("%w: %q", errs.ErrBinaryFile, fPath)
+	}
+
+	if err == nil {
+		lines = strings.Split(strings.TrimRight(string(fData), "\n"), "\n")
+
+		if selector != "" {
+			sel, err = parseExcerpt(selector)
+			if err == nil {
+				command = sel.command(fPath)
+				lines, err = sel.extract(lines)
+			}
+		}
+	}
+
+	if err == nil {
+		if strip {
+			lines = stripMarkers(lines)
+		}
+
+		if unindent {
+			lines = dedent(lines)
+		}
+
+		return strings.Join(lines, "\n"), command, nil
+	}
+
+	return "", "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// getFiles retrieves each file in the command fencing it with the supplied
+// language (inferred from the file name if empty).
+func getFiles(cmd, lang string, known ...string) (string, error) {
 	var (
-		fData []byte
-		res   string
+		opts     cmds.Options
+		dir      []string
+		fName    []string
+		strip    bool
+		unindent bool
+		content  string
+		command  string
+		res      string
+		err      error
 	)
 
-	dir, fName, err := cmds.ParseCmds(cmd)
+	cmd, opts, err = cmds.ExtractOptions(cmd, known...)
+
+	if err == nil {
+		lang = opts.Value(optLang, lang)
+		strip, err = opts.Bool(optStrip, false)
+	}
+
+	if err == nil {
+		unindent, err = opts.Bool(optDedent, false)
+	}
+
+	if err == nil {
+		dir, fName, err = cmds.ParseCmds(cmd)
+	}
+
 	for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-		fPath := dir[i] + string(os.PathSeparator) + fName[i]
-		fData, err = os.ReadFile(fPath) //nolint:gosec // Ok.
+		name, selector, _ := strings.Cut(fName[i], "#")
+		fPath := dir[i] + string(os.PathSeparator) + name
+
+		content, command, err = source(fPath, selector, strip, unindent)
 
 		if err == nil {
+			fenceLang := lang
+			if fenceLang == "" {
+				fenceLang = language(fPath)
+			}
+
 			if res != "" {
 				res += "\n\n"
 			}
 
 			res += "" +
-				format.Inline("bash", catCmd+fPath) + "\n\n" +
-				format.Inline("go", string(fData))
+				format.Inline("bash", command) + "\n\n" +
+				format.InlineSource(fenceLang, content)
 		}
 	}
 
@@ -56,3 +141,17 @@ func GetGoFile(cmd string) (string, error) {
 
 	return "", err //nolint:wrapcheck // Caller will wrap error.
 }
+
+// GetGoFile retrieves a go file.  Only a range of lines ("file.go#L10-L42")
+// or the lines between the "gotomd:region name" and "gotomd:endregion"
+// comments ("file.go#name") are included when selected.
+func GetGoFile(cmd string) (string, error) {
+	return getFiles(cmd, "go", optStrip, optDedent)
+}
+
+// GetFile retrieves a text file of any type fenced with the language
+// inferred from its name unless overridden with the lang option.  The same
+// excerpts as GetGoFile are supported.  Binary files are refused.
+func GetFile(cmd string) (string, error) {
+	return getFiles(cmd, "", optStrip, optDedent, optLang)
+}
@@ -89,3 +89,219 @@ func Test_GetFile_GetGoFile2(t *testing.T) {
 			"",
 	)
 }
+
+const excerptPath = "." + sep + "testdata" + sep + "excerpt" + sep
+
+func Test_GetFile_GetGoFileRange(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	fPath := excerptPath + "excerpt.go"
+
+	d, err := file.GetGoFile(fPath + "#L8-L10")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", "sed -n '8,10p' "+fPath)+
+			"\n\n"+
+			"```go\n"+
+			"\tif true {\n"+
+			"\t\tfmt.Println(\"setup\")\n"+
+			"\t}\n"+
+			"```",
+	)
+
+	d, err = file.GetGoFile(fPath + "#L8-L10 dedent=true")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", "sed -n '8,10p' "+fPath)+
+			"\n\n"+
+			"```go\n"+
+			"if true {\n"+
+			"\tfmt.Println(\"setup\")\n"+
+			"}\n"+
+			"```",
+	)
+
+	d, err = file.GetGoFile(fPath + "#L1")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", "sed -n '1,1p' "+fPath)+
+			"\n\n"+
+			"```go\npackage excerpt\n```",
+	)
+
+	_, err = file.GetGoFile(fPath + "#L10-L8")
+	chk.Err(err, errs.ErrInvalidRange.Error()+`: "L10-L8"`)
+
+	_, err = file.GetGoFile(fPath + "#L0")
+	chk.Err(err, errs.ErrInvalidRange.Error()+`: "L0"`)
+
+	_, err = file.GetGoFile(fPath + "#L18-L20")
+	chk.Err(err, errs.ErrInvalidRange.Error()+": L18-L20: file has 19 lines")
+}
+
+func Test_GetFile_GetGoFileRegion(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	fPath := excerptPath + "excerpt.go"
+
+	d, err := file.GetGoFile(fPath + "#setup")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+fPath+"  # region setup")+
+			"\n\n"+
+			"```go\n"+
+			"func setup() {\n"+
+			"\t// gotomd:region body\n"+
+			"\tif true {\n"+
+			"\t\tfmt.Println(\"setup\")\n"+
+			"\t}\n"+
+			"\t// gotomd:endregion\n"+
+			"}\n"+
+			"```",
+	)
+
+	d, err = file.GetGoFile(fPath + "#setup strip=true")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+fPath+"  # region setup")+
+			"\n\n"+
+			"```go\n"+
+			"func setup() {\n"+
+			"\tif true {\n"+
+			"\t\tfmt.Println(\"setup\")\n"+
+			"\t}\n"+
+			"}\n"+
+			"```",
+	)
+
+	d, err = file.GetGoFile(fPath + "#body dedent=true")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+fPath+"  # region body")+
+			"\n\n"+
+			"```go\n"+
+			"if true {\n"+
+			"\tfmt.Println(\"setup\")\n"+
+			"}\n"+
+			"```",
+	)
+
+	_, err = file.GetGoFile(fPath + "#missing")
+	chk.Err(err, errs.ErrUnknownRegion.Error()+`: "missing"`)
+
+	_, err = file.GetGoFile(fPath + "#open")
+	chk.Err(err, errs.ErrBlockNotTerminated.Error()+`: region "open"`)
+
+	_, err = file.GetGoFile(fPath + "#setup strip=maybe")
+	chk.Err(err, chk.ErrChain(errs.ErrInvalidOption, `strip="maybe"`))
+}
+
+const filesPath = "." + sep + "testdata" + sep + "files" + sep
+
+func Test_GetFile_GetFile(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	d, err := file.GetFile(filesPath + "config.yaml Dockerfile Makefile")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+filesPath+"config.yaml")+
+			"\n\n"+
+			"```yaml\n"+
+			"server:\n"+
+			"  # gotomd:region port\n"+
+			"  port: 8080\n"+
+			"  # gotomd:endregion\n"+
+			"  host: localhost\n"+
+			"```"+
+			"\n\n"+
+			format.Inline("bash", catCmd+filesPath+"Dockerfile")+
+			"\n\n"+
+			"```dockerfile\n"+
+			"FROM golang:1.25\n"+
+			"RUN go build ./...\n"+
+			"```"+
+			"\n\n"+
+			format.Inline("bash", catCmd+filesPath+"Makefile")+
+			"\n\n"+
+			"```make\nall:\n\tgo build ./...\n```",
+	)
+
+	d, err = file.GetFile(filesPath + "notes.xyz")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+filesPath+"notes.xyz")+
+			"\n\n"+
+			"```text\nplain text\n```",
+	)
+
+	d, err = file.GetFile(filesPath + "notes.xyz lang=ini")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+filesPath+"notes.xyz")+
+			"\n\n"+
+			"```ini\nplain text\n```",
+	)
+}
+
+func Test_GetFile_GetFileExcerpt(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	fPath := filesPath + "config.yaml"
+
+	d, err := file.GetFile(fPath + "#port dedent=true")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", catCmd+fPath+"  # region port")+
+			"\n\n"+
+			"```yaml\nport: 8080\n```",
+	)
+
+	d, err = file.GetFile(fPath + "#L1-L3 strip=true")
+	chk.NoErr(err)
+	chk.Str(
+		d,
+		""+
+			format.Inline("bash", "sed -n '1,3p' "+fPath)+
+			"\n\n"+
+			"```yaml\nserver:\n  port: 8080\n```",
+	)
+}
+
+func Test_GetFile_GetFileInvalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_, err := file.GetFile(filesPath + "data.bin")
+	chk.Err(err, errs.ErrBinaryFile.Error()+`: "`+filesPath+`data.bin"`)
+
+	_, err = file.GetFile(filesPath + "unknown.yaml")
+	chk.Err(err, "open "+filesPath+"unknown.yaml: no such file or directory")
+
+	// The lang option is not supported for go sources.
+	_, err = file.GetGoFile(filesPath + "notes.xyz lang=text")
+	chk.Err(err, "open "+filesPath+"lang=text: no such file or directory")
+}
@@ -0,0 +1,125 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package file
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	defaultLanguage = "text"
+	binaryProbeSize = 8000
+)
+
+// Fence languages inferred from well known file names and extensions.
+//
+//nolint:goCheckNoGlobals // Ok.
+var (
+	languageByName = map[string]string{
+		"dockerfile":    "dockerfile",
+		"containerfile": "dockerfile",
+		"makefile":      "make",
+		"gnumakefile":   "make",
+		"jenkinsfile":   "groovy",
+		"go.mod":        "go-mod",
+		"go.sum":        "text",
+		"go.work":       "go-mod",
+		".bashrc":       "bash",
+		".profile":      "bash",
+		".gitignore":    "gitignore",
+	}
+
+	languageByExt = map[string]string{
+		".bash":       "bash",
+		".c":          "c",
+		".cpp":        "cpp",
+		".css":        "css",
+		".csv":        "csv",
+		".diff":       "diff",
+		".dockerfile": "dockerfile",
+		".env":        "dotenv",
+		".go":         "go",
+		".graphql":    "graphql",
+		".h":          "c",
+		".hcl":        "hcl",
+		".html":       "html",
+		".ini":        "ini",
+		".java":       "java",
+		".js":         "javascript",
+		".json":       "json",
+		".lua":        "lua",
+		".md":         "markdown",
+		".mk":         "make",
+		".patch":      "diff",
+		".proto":      "protobuf",
+		".py":         "python",
+		".rb":         "ruby",
+		".rs":         "rust",
+		".sh":         "bash",
+		".sql":        "sql",
+		".tf":         "hcl",
+		".toml":       "toml",
+		".ts":         "typescript",
+		".txt":        "text",
+		".xml":        "xml",
+		".yaml":       "yaml",
+		".yml":        "yaml",
+		".zsh":        "zsh",
+	}
+)
+
+// language infers the fence language from the file's name (for example
+// "Dockerfile" or "Makefile") or its extension.
+func language(fPath string) string {
+	base := strings.ToLower(filepath.Base(fPath))
+
+	if lang, ok := languageByName[base]; ok {
+		return lang
+	}
+
+	// Variants such as "Dockerfile.dev".
+	if prefix, _, found := strings.Cut(base, "."); found {
+		if lang, ok := languageByName[prefix]; ok {
+			return lang
+		}
+	}
+
+	if lang, ok := languageByExt[filepath.Ext(base)]; ok {
+		return lang
+	}
+
+	return defaultLanguage
+}
+
+// isBinary reports data containing NUL bytes or invalid UTF-8 text.
+func isBinary(data []byte) bool {
+	probe := data
+	if len(probe) > binaryProbeSize {
+		probe = probe[:binaryProbeSize]
+		// Do not split a multi-byte rune.
+		for len(probe) > 0 && !utf8.RuneStart(data[len(probe)]) {
+			probe = probe[:len(probe)-1]
+		}
+	}
+
+	return bytes.IndexByte(probe, 0) >= 0 || !utf8.Valid(probe)
+}
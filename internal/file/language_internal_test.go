@@ -0,0 +1,63 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package file
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Language_Detect(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(language("./dir/main.go"), "go")
+	chk.Str(language("./dir/config.YML"), "yaml")
+	chk.Str(language("./dir/schema.sql"), "sql")
+	chk.Str(language("./dir/api.proto"), "protobuf")
+	chk.Str(language("./dir/install.sh"), "bash")
+	chk.Str(language("./dir/Dockerfile"), "dockerfile")
+	chk.Str(language("./dir/Dockerfile.dev"), "dockerfile")
+	chk.Str(language("./dir/GNUmakefile"), "make")
+	chk.Str(language("./dir/go.mod"), "go-mod")
+	chk.Str(language("./dir/.gitignore"), "gitignore")
+	chk.Str(language("./dir/README"), "text")
+	chk.Str(language("./dir/archive.tar.unknown"), "text")
+}
+
+func Test_Language_IsBinary(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.False(isBinary(nil))
+	chk.False(isBinary([]byte("plain text\n")))
+	chk.False(isBinary([]byte("héllo wörld\n")))
+	chk.True(isBinary([]byte("bin\x00ary")))
+	chk.True(isBinary([]byte{0xff, 0xfe, 'a'}))
+
+	// A multi-byte rune split by the probe is not binary.
+	long := strings.Repeat("a", binaryProbeSize-1) + "é" + "tail"
+	chk.False(isBinary([]byte(long)))
+
+	// Only the probe is examined.
+	long = strings.Repeat("a", binaryProbeSize) + "\x00"
+	chk.False(isBinary([]byte(long)))
+}
@@ -0,0 +1,19 @@
+package excerpt
+
+import "fmt"
+
+// gotomd:region setup
+func setup() {
+	// gotomd:region body
+	if true {
+		fmt.Println("setup")
+	}
+	// gotomd:endregion
+}
+
+// gotomd:endregion
+
+func unterminated() {
+	// gotomd:region open
+	fmt.Println("never closed")
+}
@@ -69,6 +69,31 @@ func Inline(language, body string) string {
 	return "```" + language + "\n" + body + "\n```"
 }
 
+// InlineSource frames the content as Inline does but only removes leading
+// blank lines preserving the indentation of the first line so an excerpt
+// taken from within a block remains aligned.
+func InlineSource(language, body string) string {
+	body = strings.TrimRight(body, "\n \t")
+	for strings.TrimSpace(body) != "" {
+		first, rest, _ := strings.Cut(body, "\n")
+		if strings.TrimSpace(first) != "" {
+			break
+		}
+
+		body = rest
+	}
+
+	if strings.TrimSpace(body) == "" {
+		return ""
+	}
+
+	if formatForGo {
+		return markForGoPackageInline(body)
+	}
+
+	return "```" + language + "\n" + body + "\n```"
+}
+
 // Comment creates a stand alone comment.
 func Comment(line string) string {
 	if formatForGo {
@@ -96,6 +121,17 @@ func BalancedComment(line string) string {
 	return Comment(line)
 }
 
+// Deprecated marks a deprecated object with a struck through name and badge
+// for an .md output.  Go package documents recognize the object's own
+// "Deprecated:" paragraph so no mark is returned.
+func Deprecated(name string) string {
+	if formatForGo {
+		return ""
+	}
+
+	return "~~`" + name + "`~~ **DEPRECATED**"
+}
+
 // HLine returns a horizontal line.
 func HLine() string {
 	const lineLength = 78
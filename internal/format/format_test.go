@@ -61,6 +61,32 @@ func TestFormat_Inline(t *testing.T) {
 	)
 }
 
+func TestFormat_InlineSource(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	format.ForMarkdown()
+
+	chk.Str(format.InlineSource("go", "\n  \n"), "")
+
+	chk.Str(
+		format.InlineSource("go", "\n  \n\tABC\n\t\tDEF\n\n"),
+		"```go\n\tABC\n\t\tDEF\n```",
+	)
+
+	chk.Str(
+		format.Inline("go", "\n  \n\tABC\n\t\tDEF\n\n"),
+		"```go\nABC\n\t\tDEF\n```",
+	)
+
+	format.ForGoDoc()
+
+	chk.Str(
+		format.InlineSource("go", "\n\tABC\n\t\tDEF"),
+		"\t    ABC\n\t        DEF",
+	)
+}
+
 func TestFormat_Comment(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
@@ -118,3 +144,24 @@ func TestFormat_HLine(t *testing.T) {
 		strings.Repeat("-", 78),
 	)
 }
+
+func TestFormat_Deprecated(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	format.ForMarkdown()
+
+	chk.Str(
+		format.Deprecated("Old"),
+		"~~`Old`~~ **DEPRECATED**",
+	)
+
+	format.ForGoDoc()
+
+	chk.Str(
+		format.Deprecated("Old"),
+		"",
+	)
+
+	format.ForMarkdown()
+}
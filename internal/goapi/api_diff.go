@@ -0,0 +1,339 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package goapi
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/update"
+	"github.com/dancsecs/szlog"
+)
+
+// Options controlling the comparison.
+const (
+	optSnapshot = "snapshot"
+	optShow     = "show"
+)
+
+// Snapshot layout and report markers.
+const (
+	pkgPrefix        = "package "
+	declIndent       = "\t"
+	markAdded        = "  + "
+	markRemoved      = "  - "
+	markChanged      = "  ~ "
+	markIncompatible = " (INCOMPATIBLE)"
+)
+
+// snapshot holds the declarations of each package keyed by package path
+// and declaration key.
+type snapshot struct {
+	pkgPaths []string
+	decls    map[string]map[string]string
+}
+
+func newSnapshot() *snapshot {
+	return &snapshot{
+		pkgPaths: nil,
+		decls:    make(map[string]map[string]string),
+	}
+}
+
+func (s *snapshot) addPackage(pkgPath string) error {
+	if _, ok := s.decls[pkgPath]; ok {
+		return fmt.Errorf("%w: duplicate package %q",
+			errs.ErrInvalidSnapshot, pkgPath,
+		)
+	}
+
+	s.pkgPaths = append(s.pkgPaths, pkgPath)
+	s.decls[pkgPath] = make(map[string]string)
+
+	return nil
+}
+
+func (s *snapshot) addDecl(pkgPath, decl string) {
+	s.decls[pkgPath][gopkg.APIKey(decl)] = decl
+}
+
+// String renders the snapshot in its file format: a package line followed
+// by each of its declarations indented one tab sorted by key.
+func (s *snapshot) String() string {
+	var res strings.Builder
+
+	for i, pkgPath := range s.pkgPaths {
+		if i > 0 {
+			res.WriteString("\n")
+		}
+
+		res.WriteString(pkgPrefix + pkgPath + "\n")
+
+		for _, key := range sortedKeys(s.decls[pkgPath]) {
+			res.WriteString(declIndent + s.decls[pkgPath][key] + "\n")
+		}
+	}
+
+	return res.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func parseSnapshot(data string) (*snapshot, error) {
+	var (
+		snap    = newSnapshot()
+		pkgPath string
+		err     error
+	)
+
+	lines := strings.Split(data, "\n")
+	for i, mi := 0, len(lines); i < mi && err == nil; i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+		case strings.HasPrefix(line, pkgPrefix):
+			pkgPath = strings.TrimSpace(strings.TrimPrefix(line, pkgPrefix))
+			err = snap.addPackage(pkgPath)
+		case strings.HasPrefix(line, declIndent) && pkgPath != "":
+			snap.addDecl(pkgPath, strings.TrimSpace(line))
+		default:
+			err = fmt.Errorf("%w: line %d: %q",
+				errs.ErrInvalidSnapshot, i+1, line,
+			)
+		}
+	}
+
+	if err == nil {
+		return snap, nil
+	}
+
+	return nil, err
+}
+
+func readSnapshot(path string) (*snapshot, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Ok.
+	if errors.Is(err, os.ErrNotExist) {
+		return newSnapshot(), nil
+	}
+
+	if err == nil {
+		return parseSnapshot(string(data))
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// breaksImplementers reports if the added declaration extends an interface
+// that was already recorded, leaving existing implementations incomplete.
+func breaksImplementers(was map[string]string, key string) bool {
+	kind, name, _ := strings.Cut(key, " ")
+	if kind != "method" && kind != "embedded" {
+		return false
+	}
+
+	typeName, _, _ := strings.Cut(name, ".")
+
+	return was["type "+typeName] == "type "+typeName+" interface"
+}
+
+// diff compares the current API against the recorded one returning a line
+// for each change.  Removed and changed declarations break existing callers
+// and are flagged as incompatible as are additions to existing interfaces.
+func diff(recorded, current *snapshot) []string {
+	var changes []string
+
+	for _, pkgPath := range current.pkgPaths {
+		was, existed := recorded.decls[pkgPath]
+		if !existed {
+			changes = append(changes, markAdded+pkgPrefix+pkgPath)
+
+			continue
+		}
+
+		now := current.decls[pkgPath]
+
+		for _, key := range sortedKeys(was) {
+			switch decl, ok := now[key]; {
+			case !ok:
+				changes = append(changes,
+					markRemoved+pkgPath+": "+was[key]+markIncompatible,
+				)
+			case decl != was[key]:
+				changes = append(changes,
+					markChanged+pkgPath+": "+was[key]+" => "+decl+
+						markIncompatible,
+				)
+			}
+		}
+
+		for _, key := range sortedKeys(now) {
+			if _, ok := was[key]; !ok {
+				change := markAdded + pkgPath + ": " + now[key]
+				if breaksImplementers(was, key) {
+					change += markIncompatible
+				}
+
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	for _, pkgPath := range recorded.pkgPaths {
+		if _, ok := current.decls[pkgPath]; !ok {
+			changes = append(changes,
+				markRemoved+pkgPrefix+pkgPath+markIncompatible,
+			)
+		}
+	}
+
+	return changes
+}
+
+func parseAPICmd(
+	cmd string,
+) ([]string, string, bool, gopkg.Build, error) {
+	var (
+		dirs    []string
+		actions []string
+		opts    cmds.Options
+		build   gopkg.Build
+		show    bool
+		snap    string
+		err     error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(
+		cmd, gopkg.BuildOptions(optSnapshot, optShow)...,
+	)
+
+	if err == nil {
+		build = gopkg.BuildFromOptions(opts)
+		show, err = opts.Bool(optShow, true)
+	}
+
+	if err == nil {
+		snap = filepath.Clean(opts.Value(optSnapshot, ""))
+
+		switch {
+		case !opts.Has(optSnapshot):
+			err = fmt.Errorf("%w: %s", errs.ErrMissingOption, optSnapshot)
+		case !filepath.IsLocal(snap):
+			err = fmt.Errorf("%w: %q", errs.ErrNotLocalDir, snap)
+		}
+	}
+
+	if err == nil {
+		dirs, actions, err = cmds.ParseCmds(cmd)
+	}
+
+	for i, mi := 0, len(actions); i < mi && err == nil; i++ {
+		if actions[i] != "." {
+			err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, actions[i])
+		}
+	}
+
+	if err == nil {
+		return dirs, snap, show, build, nil
+	}
+
+	return nil, "", false, build, err
+}
+
+// GetAPIDiff records the exported API of the listed packages into the
+// snapshot file reporting any differences from the previously recorded
+// API.  The snapshot is only rewritten when it has changed so running with
+// the up-to-date check fails whenever an API change has not been recorded.
+func GetAPIDiff(cmd string) (string, error) {
+	var (
+		dirs     []string
+		snapPath string
+		show     bool
+		build    gopkg.Build
+		pkgPath  string
+		entries  []gopkg.APIEntry
+		recorded *snapshot
+		current  = newSnapshot()
+		err      error
+	)
+
+	dirs, snapPath, show, build, err = parseAPICmd(cmd)
+
+	for i, mi := 0, len(dirs); i < mi && err == nil; i++ {
+		pkgPath, entries, err = gopkg.API(dirs[i], build)
+		if err == nil {
+			err = current.addPackage(pkgPath)
+		}
+
+		for j, mj := 0, len(entries); j < mj && err == nil; j++ {
+			current.addDecl(pkgPath, entries[j].Decl)
+		}
+	}
+
+	if err == nil {
+		recorded, err = readSnapshot(snapPath)
+	}
+
+	if err == nil {
+		changes := diff(recorded, current)
+		if len(changes) > 0 {
+			szlog.Say0("API changes recorded in: ", snapPath, "\n")
+
+			for _, change := range changes {
+				szlog.Say0(change, "\n")
+			}
+		}
+
+		_, err = update.File(
+			snapPath,
+			args.Force(),
+			args.CheckUpToDate(),
+			current.String(),
+			args.Perm(),
+		)
+	}
+
+	if err == nil && show {
+		return format.Inline("go", current.String()), nil
+	}
+
+	if err == nil {
+		return "", nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
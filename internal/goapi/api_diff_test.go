@@ -0,0 +1,220 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package goapi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/goapi"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/gotomd/internal/update"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+const (
+	libV2 = "" +
+		"package lib\n\n" +
+		"func Keep(renamed int) int { return renamed }\n\n" +
+		"func Change(a int64) int { return int(a) }\n\n" +
+		"func Add() {}\n"
+
+	snapshotV1 = "" +
+		"package example.com/app/lib\n" +
+		"\tfunc Change(int) int\n" +
+		"\tfunc Keep(int) int\n" +
+		"\tfunc Remove()\n"
+)
+
+// setupModule copies the module containing a single library package and
+// changes into its root directory returning the library's directory.
+func setupModule(t *testing.T, chk *sztest.Chk, procArgs ...string) string {
+	t.Helper()
+
+	dir := testmod.Setup(t, chk, "app")
+	testmod.Args(chk, procArgs...)
+
+	gopkg.Reset()
+	update.ResetUpToDate()
+
+	return filepath.Join(dir, "lib")
+}
+
+func Test_GetAPIDiff_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	_ = setupModule(t, chk, "-f")
+
+	_, err := goapi.GetAPIDiff("./lib/.")
+	chk.Err(err, errs.ErrMissingOption.Error()+": snapshot")
+
+	_, err = goapi.GetAPIDiff("./lib/. snapshot=../api.txt")
+	chk.Err(err, errs.ErrNotLocalDir.Error()+`: "../api.txt"`)
+
+	_, err = goapi.GetAPIDiff("./lib/. snapshot=api.txt show=maybe")
+	chk.Err(err, chk.ErrChain(errs.ErrInvalidOption, `show="maybe"`))
+
+	_, err = goapi.GetAPIDiff("./lib/Keep snapshot=api.txt")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "Keep"`)
+
+	_, err = goapi.GetAPIDiff("./lib/. ./lib/. snapshot=api.txt")
+	chk.Err(err, ""+
+		errs.ErrInvalidSnapshot.Error()+
+		`: duplicate package "example.com/app/lib"`,
+	)
+
+	chk.NoErr(os.WriteFile("api.txt", []byte("\tfunc Orphan()\n"), 0o600))
+
+	_, err = goapi.GetAPIDiff("./lib/. snapshot=api.txt")
+	chk.Err(err, ""+
+		errs.ErrInvalidSnapshot.Error()+
+		`: line 1: "\tfunc Orphan()"`,
+	)
+
+	chk.Stdout()
+}
+
+func Test_GetAPIDiff_Record(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	lib := setupModule(t, chk, "-f")
+
+	// Initial snapshot.
+	res, err := goapi.GetAPIDiff("./lib/. snapshot=api.txt")
+	chk.NoErr(err)
+	chk.Str(res, "```go\n"+snapshotV1+"```")
+
+	data, err := os.ReadFile("api.txt")
+	chk.NoErr(err)
+	chk.Str(string(data), snapshotV1)
+
+	// Unchanged.
+	gopkg.Reset()
+
+	res, err = goapi.GetAPIDiff("./lib/. snapshot=api.txt show=false")
+	chk.NoErr(err)
+	chk.Str(res, "")
+
+	// Changed.
+	gopkg.Reset()
+
+	chk.NoErr(
+		os.WriteFile(filepath.Join(lib, "lib.go"), []byte(libV2), 0o600),
+	)
+
+	_, err = goapi.GetAPIDiff("./lib/. snapshot=api.txt")
+	chk.NoErr(err)
+
+	data, err = os.ReadFile("api.txt")
+	chk.NoErr(err)
+	chk.Str(string(data), ""+
+		"package example.com/app/lib\n"+
+		"\tfunc Add()\n"+
+		"\tfunc Change(int64) int\n"+
+		"\tfunc Keep(int) int\n",
+	)
+
+	chk.Stdout(
+		"API changes recorded in: api.txt",
+		"  + package example.com/app/lib",
+		"API changes recorded in: api.txt",
+		"  ~ example.com/app/lib: func Change(int) int => "+
+			"func Change(int64) int (INCOMPATIBLE)",
+		"  - example.com/app/lib: func Remove() (INCOMPATIBLE)",
+		"  + example.com/app/lib: func Add()",
+	)
+}
+
+func Test_GetAPIDiff_UpToDate(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	_ = setupModule(t, chk, "-u")
+
+	chk.NoErr(os.WriteFile("api.txt", []byte(""+
+		"package example.com/app/lib\n"+
+		"\tfunc Change(int) int\n"+
+		"\tfunc Keep(int) int\n\n"+
+		"package example.com/app/gone\n"+
+		"\tfunc Gone()\n",
+	), 0o600))
+
+	_, err := goapi.GetAPIDiff("./lib/. snapshot=api.txt show=false")
+	chk.NoErr(err)
+	chk.False(update.IsUpToDate())
+
+	data, err := os.ReadFile("api.txt")
+	chk.NoErr(err)
+	chk.Str(string(data), ""+
+		"package example.com/app/lib\n"+
+		"\tfunc Change(int) int\n"+
+		"\tfunc Keep(int) int\n\n"+
+		"package example.com/app/gone\n"+
+		"\tfunc Gone()\n",
+	)
+
+	chk.Stdout(
+		"API changes recorded in: api.txt",
+		"  + example.com/app/lib: func Remove()",
+		"  - package example.com/app/gone (INCOMPATIBLE)",
+	)
+}
+
+func Test_GetAPIDiff_InterfaceMethodAdded(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	lib := setupModule(t, chk, "-u")
+
+	chk.NoErr(os.WriteFile(filepath.Join(lib, "lib.go"), []byte(""+
+		"package lib\n\n"+
+		"type Shape interface {\n"+
+		"\tArea() float64\n"+
+		"\tPerimeter() float64\n"+
+		"}\n\n"+
+		"type Square struct{ Side float64 }\n\n"+
+		"func (s Square) Area() float64 { return s.Side * s.Side }\n\n"+
+		"func (s Square) Perimeter() float64 { return 4 * s.Side }\n",
+	), 0o600))
+
+	chk.NoErr(os.WriteFile("api.txt", []byte(""+
+		"package example.com/app/lib\n"+
+		"\ttype Shape interface\n"+
+		"\tmethod (Shape) Area() float64\n"+
+		"\ttype Square struct\n"+
+		"\tfield Square.Side float64\n"+
+		"\tmethod (Square) Area() float64\n",
+	), 0o600))
+
+	_, err := goapi.GetAPIDiff("./lib/. snapshot=api.txt show=false")
+	chk.NoErr(err)
+
+	chk.Stdout(
+		"API changes recorded in: api.txt",
+		"  + example.com/app/lib: method (Shape) Perimeter() float64"+
+			" (INCOMPATIBLE)",
+		"  + example.com/app/lib: method (Square) Perimeter() float64",
+	)
+}
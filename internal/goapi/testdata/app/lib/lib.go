@@ -0,0 +1,7 @@
+package lib
+
+func Keep(a int) int { return a }
+
+func Change(a int) int { return a }
+
+func Remove() {}
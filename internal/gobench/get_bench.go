@@ -0,0 +1,294 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gobench
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/gotomd/internal/update"
+)
+
+// Options controlling the benchmark run.
+const (
+	optBenchtime = "benchtime"
+	optCount     = "count"
+	optBenchmem  = "benchmem"
+	optBaseline  = "baseline"
+	optVolatile  = "volatile"
+	optTimeout   = "timeout"
+)
+
+const (
+	defaultBenchtime = "1s"
+	defaultCount     = 1
+)
+
+// "100x" iterations.
+var benchIterations = regexp.MustCompile(`^[1-9]\d*x$`)
+
+// Lines of go test -bench output kept in a baseline (as read by benchstat).
+var baselineLine = regexp.MustCompile(`^(?:goos|goarch|pkg|cpu): |^Benchmark`)
+
+type benchOptions struct {
+	benchtime string
+	count     int
+	benchmem  bool
+	baseline  string
+	volatile  bool
+	timeout   time.Duration
+}
+
+func parseBenchtime(value string) error {
+	if benchIterations.MatchString(value) {
+		return nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optBenchtime, value,
+		)
+	}
+
+	return nil
+}
+
+func parseBenchCmd(cmd string) (string, string, *benchOptions, error) {
+	var (
+		dir      string
+		pattern  string
+		opts     cmds.Options
+		benchOpt = new(benchOptions)
+		err      error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(cmd,
+		optBenchtime, optCount, optBenchmem, optBaseline, optVolatile,
+		optTimeout,
+	)
+
+	if err == nil {
+		benchOpt.benchtime = opts.Value(optBenchtime, defaultBenchtime)
+		err = parseBenchtime(benchOpt.benchtime)
+	}
+
+	if err == nil {
+		benchOpt.count, err = opts.Int(optCount, defaultCount)
+	}
+
+	if err == nil && benchOpt.count < 1 {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optCount, opts[optCount],
+		)
+	}
+
+	if err == nil {
+		benchOpt.benchmem, err = opts.Bool(optBenchmem, false)
+	}
+
+	if err == nil {
+		benchOpt.volatile, err = opts.Bool(optVolatile, false)
+	}
+
+	if err == nil && opts.Has(optBaseline) {
+		benchOpt.baseline = filepath.Clean(opts.Value(optBaseline, ""))
+		if !filepath.IsLocal(benchOpt.baseline) {
+			err = fmt.Errorf("%w: %q", errs.ErrNotLocalDir, benchOpt.baseline)
+		}
+	}
+
+	if err == nil {
+		benchOpt.timeout, err = opts.Duration(optTimeout, proc.Timeout())
+	}
+
+	if err == nil {
+		dir, pattern, err = cmds.ParseCmd(strings.TrimSpace(cmd))
+	}
+
+	if err == nil {
+		return dir, pattern, benchOpt, nil
+	}
+
+	return "", "", nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// runBench runs the named benchmark (or all benchmarks if the pattern is
+// ".") returning the go test output.
+func runBench(dir, pattern string, opts *benchOptions) (string, error) {
+	var (
+		rawRes   []byte
+		runDir   string
+		relDir   string
+		wsEnv    []string
+		benchArg []string
+		res      string
+	)
+
+	runDir, relDir, wsEnv, err := gowork.Target(dir)
+
+	if pattern != "." {
+		pattern = "^" + pattern + "$"
+	}
+
+	if err == nil {
+		benchArg = []string{
+			"test", "-run", "^$", "-bench", pattern,
+			"-benchtime", opts.benchtime,
+			"-count", strconv.Itoa(opts.count),
+		}
+
+		if opts.benchmem {
+			benchArg = append(benchArg, "-benchmem")
+		}
+
+		c := proc.Command(opts.timeout, "go", append(benchArg, relDir)...)
+		c.Dir = runDir
+		c.Env = append(os.Environ(), wsEnv...)
+
+		rawRes, err = c.CombinedOutput()
+		res = string(rawRes)
+
+		if err != nil && !proc.Stopped(err) {
+			res, err = normalize.String(res)
+			if err == nil {
+				err = fmt.Errorf("%w: go %s %s\n%s",
+					errs.ErrTestsFailed, strings.Join(benchArg, " "), dir,
+					strings.TrimRight(res, "\n"),
+				)
+			}
+		}
+	}
+
+	if err == nil {
+		return res, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// baselineText returns the benchmark output kept in a baseline file.
+func baselineText(raw string) string {
+	var kept []string
+
+	for _, line := range strings.Split(raw, "\n") {
+		if baselineLine.MatchString(line) {
+			kept = append(kept, line)
+		}
+	}
+
+	return strings.Join(kept, "\n")
+}
+
+// compareBaseline renders the results compared against the baseline.  A
+// missing baseline is created from the current results.
+func compareBaseline(cur *results, raw, baseline string) (string, error) {
+	data, err := os.ReadFile(baseline) //nolint:gosec // Ok.
+
+	if errors.Is(err, os.ErrNotExist) {
+		_, err = update.File(
+			baseline,
+			args.Force(),
+			args.CheckUpToDate(),
+			baselineText(raw),
+			args.Perm(),
+		)
+		if err == nil {
+			return cur.table(), nil
+		}
+	}
+
+	if err == nil {
+		return cur.compare(parseResults(string(data))), nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// GetBench runs the named benchmark in the package directory
+// ("./dir/BenchmarkSum" or "./dir/." for all benchmarks) rendering their
+// results, including any -benchmem and custom metrics, as a table.  If a
+// baseline file is provided the results are compared against it in the
+// style of benchstat.  Volatile results are bracketed so up to date
+// checks ignore them and are not run when only checking.
+func GetBench(cmd string) (string, error) {
+	var (
+		dir     string
+		pattern string
+		opts    *benchOptions
+		raw     string
+		cur     *results
+		res     string
+		err     error
+	)
+
+	dir, pattern, opts, err = parseBenchCmd(cmd)
+
+	if err == nil && opts.volatile && args.CheckUpToDate() {
+		return update.Volatile(""), nil
+	}
+
+	if err == nil {
+		raw, err = runBench(dir, pattern, opts)
+	}
+
+	if err == nil {
+		cur = parseResults(raw)
+		if len(cur.names) == 0 {
+			err = fmt.Errorf("%w: %s", errs.ErrNoBenchToRun, pattern)
+		}
+	}
+
+	if err == nil {
+		if opts.baseline == "" {
+			res = cur.table()
+		} else {
+			res, err = compareBaseline(cur, raw, opts.baseline)
+		}
+	}
+
+	if err == nil {
+		if format.IsForMarkdown() {
+			res = strings.TrimRight(res, "\n")
+		} else {
+			res = format.Inline("text", res)
+		}
+
+		if opts.volatile {
+			res = update.Volatile(res)
+		}
+
+		return res, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
@@ -0,0 +1,144 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gobench_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gobench"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/gotomd/internal/update"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupModule(t *testing.T, chk *sztest.Chk, procArgs ...string) {
+	t.Helper()
+
+	_ = testmod.Setup(t, chk, "app")
+	testmod.Args(chk, procArgs...)
+
+	update.ResetUpToDate()
+}
+
+func Test_GetBench_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	_, err := gobench.GetBench("./. benchtime=soon")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: benchtime="soon"`)
+
+	_, err = gobench.GetBench("./. count=0")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: count="0"`)
+
+	_, err = gobench.GetBench("./. baseline=../bench.txt")
+	chk.Err(err, errs.ErrNotLocalDir.Error()+`: "../bench.txt"`)
+
+	_, err = gobench.GetBench("./BenchmarkNone benchtime=1x")
+	chk.Err(err, errs.ErrNoBenchToRun.Error()+": BenchmarkNone")
+}
+
+func Test_GetBench(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	res, err := gobench.GetBench("./BenchmarkWidgets benchtime=1x count=2")
+	chk.NoErr(err)
+	chk.True(strings.HasPrefix(res, ""+
+		"| Benchmark | ns/op | widgets/op |\n"+
+		"| --------- | ---: | ---: |\n"+
+		"| BenchmarkWidgets | ",
+	))
+	chk.True(strings.HasSuffix(res, " | 3 |"))
+	chk.False(strings.Contains(res, "BenchmarkWidgetsLarge"))
+
+	res, err = gobench.GetBench("./. benchtime=1x benchmem=true")
+	chk.NoErr(err)
+	chk.True(strings.HasPrefix(res, ""+
+		"| Benchmark | ns/op | widgets/op | B/op | allocs/op |\n",
+	))
+	chk.True(strings.Contains(res, "\n| BenchmarkWidgetsLarge | "))
+}
+
+func Test_GetBench_Baseline(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk, "-f")
+
+	res, err := gobench.GetBench(
+		"./BenchmarkWidgets benchtime=1x baseline=bench.txt",
+	)
+	chk.NoErr(err)
+	chk.True(strings.HasPrefix(res, "| Benchmark | ns/op | widgets/op |\n"))
+
+	data, err := os.ReadFile("bench.txt")
+	chk.NoErr(err)
+	chk.True(strings.Contains(string(data), "\nBenchmarkWidgets"))
+	chk.False(strings.Contains(string(data), "PASS"))
+
+	res, err = gobench.GetBench(
+		"./BenchmarkWidgets benchtime=1x baseline=bench.txt",
+	)
+	chk.NoErr(err)
+	chk.True(strings.HasPrefix(res, ""+
+		"| Benchmark |"+
+		" old ns/op | new ns/op | delta |"+
+		" old widgets/op | new widgets/op | delta |\n",
+	))
+	chk.True(strings.HasSuffix(res, " | 3 | 3 | +0.00% |"))
+}
+
+func Test_GetBench_Volatile(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	res, err := gobench.GetBench(
+		"./BenchmarkWidgets benchtime=1x volatile=true",
+	)
+	chk.NoErr(err)
+	chk.True(strings.HasPrefix(res, ""+
+		"<!--- "+update.VolatileBegin+" -->\n"+
+		"| Benchmark | ns/op | widgets/op |\n",
+	))
+	chk.True(strings.HasSuffix(res, ""+
+		" | 3 |\n"+
+		"<!--- "+update.VolatileEnd+" -->",
+	))
+
+	testmod.Args(chk, "-u")
+	update.ResetUpToDate()
+
+	res, err = gobench.GetBench("./BenchmarkNone volatile=true")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"<!--- "+update.VolatileBegin+" -->\n"+
+		"\n"+
+		"<!--- "+update.VolatileEnd+" -->",
+	)
+}
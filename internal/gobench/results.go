@@ -0,0 +1,281 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gobench
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A benchmark result line.
+// "BenchmarkSum-8   	 1000000	      1052 ns/op	     128 B/op".
+var resultLine = regexp.MustCompile(
+	`^(Benchmark\S*?)(?:-\d+)?\s+\d+\s+(.+)$`,
+)
+
+const maxPercent = 100
+
+// result holds every sample of each metric reported by a benchmark.
+type result struct {
+	samples map[string][]float64
+}
+
+// results holds the benchmarks (and the units of their metrics) in the
+// order first reported.  The GOMAXPROCS suffix ("-8") is dropped from the
+// names so results compare across machines.
+type results struct {
+	names  []string
+	units  []string
+	byName map[string]*result
+}
+
+func newResults() *results {
+	return &results{
+		names:  nil,
+		units:  nil,
+		byName: make(map[string]*result),
+	}
+}
+
+func (r *results) add(name, unit string, value float64) {
+	res, ok := r.byName[name]
+	if !ok {
+		res = &result{samples: make(map[string][]float64)}
+		r.byName[name] = res
+		r.names = append(r.names, name)
+	}
+
+	if !slices.Contains(r.units, unit) {
+		r.units = append(r.units, unit)
+	}
+
+	res.samples[unit] = append(res.samples[unit], value)
+}
+
+// parseResults extracts the results from go test -bench output (the same
+// format read by benchstat) ignoring any other lines.
+func parseResults(raw string) *results {
+	r := newResults()
+
+	for _, line := range strings.Split(raw, "\n") {
+		match := resultLine.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+
+		fields := strings.Fields(match[2])
+		for i := 0; i+1 < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err == nil {
+				r.add(match[1], fields[i+1], value)
+			}
+		}
+	}
+
+	return r
+}
+
+// summary describes the samples of a single metric.
+type summary struct {
+	count  int
+	median float64
+	min    float64
+	max    float64
+}
+
+func summarize(samples []float64) summary {
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	s := summary{
+		count:  len(sorted),
+		median: 0,
+		min:    0,
+		max:    0,
+	}
+
+	if s.count > 0 {
+		const half = 2
+
+		mid := s.count / half
+		s.min = sorted[0]
+		s.max = sorted[s.count-1]
+		s.median = sorted[mid]
+
+		if s.count%half == 0 {
+			s.median = (sorted[mid-1] + sorted[mid]) / half
+		}
+	}
+
+	return s
+}
+
+// spread returns the largest deviation from the median as a percentage.
+func (s summary) spread() float64 {
+	if s.median == 0 {
+		return 0
+	}
+
+	return max(s.max-s.median, s.median-s.min) * maxPercent / s.median
+}
+
+// overlaps returns true if the ranges of repeated samples overlap so any
+// difference is not considered significant.
+func (s summary) overlaps(o summary) bool {
+	return (s.count > 1 || o.count > 1) && s.min <= o.max && o.min <= s.max
+}
+
+func formatValue(v float64) string {
+	const (
+		whole = 100
+		tenth = 10
+	)
+
+	switch {
+	case v >= whole || v == float64(int64(v)):
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	case v >= tenth:
+		return strconv.FormatFloat(v, 'f', 1, 64)
+	default:
+		return strconv.FormatFloat(v, 'g', 3, 64)
+	}
+}
+
+func (s summary) String() string {
+	if s.count == 0 {
+		return ""
+	}
+
+	res := formatValue(s.median)
+
+	if spread := s.spread(); spread >= 1 {
+		res += fmt.Sprintf(" ±%.0f%%", spread)
+	}
+
+	return res
+}
+
+func (r *results) summary(name, unit string) summary {
+	res, ok := r.byName[name]
+	if !ok {
+		return summarize(nil)
+	}
+
+	return summarize(res.samples[unit])
+}
+
+// delta reports the change from the old to the new median or "~" when the
+// samples overlap.
+func delta(old, cur summary) string {
+	switch {
+	case old.count == 0 || cur.count == 0:
+		return ""
+	case old.overlaps(cur):
+		return "~"
+	case old.median == 0:
+		return ""
+	default:
+		return fmt.Sprintf("%+.2f%%",
+			(cur.median-old.median)*maxPercent/old.median,
+		)
+	}
+}
+
+func escapeName(name string) string {
+	return strings.ReplaceAll(name, "|", `\|`)
+}
+
+// table renders the results with a column for each unit.
+func (r *results) table() string {
+	var res strings.Builder
+
+	res.WriteString("| Benchmark |")
+
+	for _, unit := range r.units {
+		res.WriteString(" " + unit + " |")
+	}
+
+	res.WriteString("\n| --------- |" +
+		strings.Repeat(" ---: |", len(r.units)) + "\n",
+	)
+
+	for _, name := range r.names {
+		res.WriteString("| " + escapeName(name) + " |")
+
+		for _, unit := range r.units {
+			res.WriteString(" " + r.summary(name, unit).String() + " |")
+		}
+
+		res.WriteString("\n")
+	}
+
+	return res.String()
+}
+
+// compare renders the baseline and current results of each unit along with
+// their change in the style of benchstat.
+func (r *results) compare(base *results) string {
+	var (
+		res   strings.Builder
+		names = slices.Clone(base.names)
+		units = slices.Clone(base.units)
+	)
+
+	for _, name := range r.names {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, unit := range r.units {
+		if !slices.Contains(units, unit) {
+			units = append(units, unit)
+		}
+	}
+
+	res.WriteString("| Benchmark |")
+
+	for _, unit := range units {
+		res.WriteString(" old " + unit + " | new " + unit + " | delta |")
+	}
+
+	res.WriteString("\n| --------- |" +
+		strings.Repeat(" ---: | ---: | ---: |", len(units)) + "\n",
+	)
+
+	for _, name := range names {
+		res.WriteString("| " + escapeName(name) + " |")
+
+		for _, unit := range units {
+			old := base.summary(name, unit)
+			cur := r.summary(name, unit)
+
+			res.WriteString(" " + old.String() + " | " + cur.String() +
+				" | " + delta(old, cur) + " |",
+			)
+		}
+
+		res.WriteString("\n")
+	}
+
+	return res.String()
+}
@@ -0,0 +1,112 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gobench
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+const (
+	baseRaw = "" +
+		"goos: linux\n" +
+		"pkg: example.com/app\n" +
+		"BenchmarkSum-8\t1000\t1000\tns/op\t64\tB/op\t1\tallocs/op\n" +
+		"BenchmarkSum-8\t1000\t1100\tns/op\t64\tB/op\t1\tallocs/op\n" +
+		"BenchmarkSum-8\t1000\t900\tns/op\t64\tB/op\t1\tallocs/op\n" +
+		"BenchmarkOld-8\t1000\t2.5\tns/op\n" +
+		"PASS\n"
+
+	curRaw = "" +
+		"BenchmarkSum-8\t1000\t500\tns/op\t32\tB/op\t1\tallocs/op" +
+		"\t3.00\twidgets/op\n" +
+		"BenchmarkSum-8\t1000\t520\tns/op\t32\tB/op\t1\tallocs/op" +
+		"\t3.00\twidgets/op\n" +
+		"BenchmarkNew/a|b-8\t1000\t12.25\tns/op\n" +
+		"ok  	example.com/app	1.234s\n"
+)
+
+func Test_Results_Parse(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	r := parseResults(baseRaw)
+	chk.StrSlice(r.names, []string{"BenchmarkSum", "BenchmarkOld"})
+	chk.StrSlice(r.units, []string{"ns/op", "B/op", "allocs/op"})
+
+	s := r.summary("BenchmarkSum", "ns/op")
+	chk.Int(s.count, 3)
+	chk.Str(s.String(), "1000 ±10%")
+
+	s = r.summary("BenchmarkOld", "B/op")
+	chk.Int(s.count, 0)
+	chk.Str(s.String(), "")
+
+	s = summarize([]float64{4, 1, 3, 2})
+	chk.Str(formatValue(s.median), "2.5")
+	chk.Str(formatValue(s.min), "1")
+	chk.Str(formatValue(12.25), "12.2")
+	chk.Str(formatValue(0.012345), "0.0123")
+}
+
+func Test_Results_Table(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(parseResults(curRaw).table(), ""+
+		"| Benchmark | ns/op | B/op | allocs/op | widgets/op |\n"+
+		"| --------- | ---: | ---: | ---: | ---: |\n"+
+		"| BenchmarkSum | 510 ±2% | 32 | 1 | 3 |\n"+
+		`| BenchmarkNew/a\|b | 12.2 |  |  |  |`+"\n",
+	)
+}
+
+func Test_Results_Compare(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(parseResults(curRaw).compare(parseResults(baseRaw)), ""+
+		"| Benchmark |"+
+		" old ns/op | new ns/op | delta |"+
+		" old B/op | new B/op | delta |"+
+		" old allocs/op | new allocs/op | delta |"+
+		" old widgets/op | new widgets/op | delta |\n"+
+		"| --------- |"+
+		" ---: | ---: | ---: |"+
+		" ---: | ---: | ---: |"+
+		" ---: | ---: | ---: |"+
+		" ---: | ---: | ---: |\n"+
+		"| BenchmarkSum |"+
+		" 1000 ±10% | 510 ±2% | -49.00% |"+
+		" 64 | 32 | -50.00% |"+
+		" 1 | 1 | ~ |"+
+		"  | 3 |  |\n"+
+		"| BenchmarkOld |"+
+		" 2.5 |  |  |"+
+		"  |  |  |"+
+		"  |  |  |"+
+		"  |  |  |\n"+
+		`| BenchmarkNew/a\|b |`+
+		"  | 12.2 |  |"+
+		"  |  |  |"+
+		"  |  |  |"+
+		"  |  |  |\n",
+	)
+}
@@ -0,0 +1,19 @@
+package app
+
+import "testing"
+
+var sink []byte
+
+func BenchmarkWidgets(b *testing.B) {
+	for range b.N {
+		sink = make([]byte, 64)
+	}
+
+	b.ReportMetric(3, "widgets/op")
+}
+
+func BenchmarkWidgetsLarge(b *testing.B) {
+	for range b.N {
+		sink = make([]byte, 4096)
+	}
+}
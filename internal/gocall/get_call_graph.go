@@ -0,0 +1,453 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gocall
+
+import (
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/szlog"
+	"golang.org/x/tools/go/callgraph"
+	"golang.org/x/tools/go/callgraph/static"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// Options controlling the excerpt.
+const (
+	optDepth     = "depth"
+	optDirection = "direction"
+	optFormat    = "format"
+)
+
+// Supported option values.
+const (
+	directionCallees = "callees"
+	directionCallers = "callers"
+	formatMermaid    = "mermaid"
+	formatList       = "list"
+	defaultDepth     = 2
+	listIndent       = "    "
+	recursiveMark    = " (recursive)"
+)
+
+// moduleGraph holds the static call graph of all packages in a module.
+type moduleGraph struct {
+	pkgs    map[string]*packages.Package // Keyed by absolute directory.
+	ssaPkgs map[*packages.Package]*ssa.Package
+	graph   *callgraph.Graph
+}
+
+//nolint:goCheckNoGlobals // Ok.
+var graphCache = make(map[string]*moduleGraph)
+
+// Reset clears all cached call graphs.
+func Reset() {
+	for k := range graphCache {
+		delete(graphCache, k)
+	}
+}
+
+func loadModule(modDir string, env []string) (*moduleGraph, error) {
+	var (
+		pkgs []*packages.Package
+		err  error
+	)
+
+	szlog.Say1("Building call graph for: ", modDir, "\n")
+
+	cfg := new(packages.Config)
+	cfg.Dir = modDir
+	cfg.Env = append(os.Environ(), env...)
+	cfg.Mode = packages.LoadSyntax | packages.NeedModule
+	cfg.Tests = false
+
+	pkgs, err = proc.LoadPackages(cfg, "."+string(os.PathSeparator)+"...")
+
+	for i, mi := 0, len(pkgs); i < mi && err == nil; i++ {
+		if len(pkgs[i].Errors) > 0 {
+			err = fmt.Errorf(
+				"%w: %q: %v",
+				errs.ErrInvalidPackage, pkgs[i].PkgPath, pkgs[i].Errors[0],
+			)
+		}
+	}
+
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	prog, ssaPkgs := ssautil.Packages(pkgs, ssa.InstantiateGenerics)
+	prog.Build()
+
+	mg := &moduleGraph{
+		pkgs:    make(map[string]*packages.Package, len(pkgs)),
+		ssaPkgs: make(map[*packages.Package]*ssa.Package, len(pkgs)),
+		graph:   static.CallGraph(prog),
+	}
+
+	for i, pkg := range pkgs {
+		if len(pkg.GoFiles) > 0 {
+			mg.pkgs[filepath.Dir(pkg.GoFiles[0])] = pkg
+			mg.ssaPkgs[pkg] = ssaPkgs[i]
+		}
+	}
+
+	return mg, nil
+}
+
+func getModuleGraph(dir string) (*moduleGraph, error) {
+	var (
+		modDir string
+		env    []string
+		mg     *moduleGraph
+		ok     bool
+		err    error
+	)
+
+	modDir, err = gowork.ModuleRoot(dir)
+
+	if err == nil && modDir == "" {
+		err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, dir)
+	}
+
+	if err == nil {
+		env, err = gowork.Env(dir)
+	}
+
+	if err == nil {
+		mg, ok = graphCache[modDir]
+		if !ok {
+			mg, err = loadModule(modDir, env)
+			if err == nil {
+				graphCache[modDir] = mg
+			}
+		}
+	}
+
+	if err == nil {
+		return mg, nil
+	}
+
+	return nil, err
+}
+
+// lookup finds the named function ("Func") or method ("Type.Method") in the
+// package located in the directory.
+func (mg *moduleGraph) lookup(dir, name string) (*ssa.Function, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	pkg, ok := mg.pkgs[absDir]
+	if !ok || mg.ssaPkgs[pkg] == nil {
+		return nil, fmt.Errorf("%w: %q", errs.ErrInvalidPackage, dir)
+	}
+
+	ssaPkg := mg.ssaPkgs[pkg]
+	typeName, methodName, isMethod := strings.Cut(name, ".")
+
+	var fn *ssa.Function
+
+	if isMethod {
+		fn = lookupMethod(ssaPkg, typeName, methodName)
+	} else {
+		fn = ssaPkg.Func(name)
+	}
+
+	if fn == nil {
+		return nil, fmt.Errorf("%w: %s", errs.ErrUnknownObject, name)
+	}
+
+	return fn, nil
+}
+
+func lookupMethod(ssaPkg *ssa.Package, typeName, name string) *ssa.Function {
+	obj, ok := ssaPkg.Pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	for m := range named.Methods() {
+		if m.Name() == name {
+			return ssaPkg.Prog.FuncValue(m)
+		}
+	}
+
+	return nil
+}
+
+// label returns the function name qualified by its package name.
+func label(fn *ssa.Function) string {
+	if fn.Pkg == nil {
+		return fn.String()
+	}
+
+	return fn.Pkg.Pkg.Name() + "." + fn.RelString(fn.Pkg.Pkg)
+}
+
+// inModule reports if the function belongs to one of the module's
+// packages.
+func (mg *moduleGraph) inModule(fn *ssa.Function) bool {
+	if fn == nil || fn.Pkg == nil {
+		return false
+	}
+
+	for pkg, ssaPkg := range mg.ssaPkgs {
+		if ssaPkg == fn.Pkg {
+			return pkg.Module != nil && pkg.Module.Main
+		}
+	}
+
+	return false
+}
+
+// related returns the unique module functions called by (callees) or
+// calling (callers) the function sorted by label.
+func (mg *moduleGraph) related(
+	fn *ssa.Function, callers bool,
+) []*ssa.Function {
+	var (
+		node  = mg.graph.Nodes[fn]
+		seen  = make(map[*ssa.Function]bool)
+		funcs []*ssa.Function
+		edges []*callgraph.Edge
+	)
+
+	if node == nil {
+		return nil
+	}
+
+	edges = node.Out
+	if callers {
+		edges = node.In
+	}
+
+	for _, e := range edges {
+		other := e.Callee.Func
+		if callers {
+			other = e.Caller.Func
+		}
+
+		if !seen[other] && mg.inModule(other) {
+			seen[other] = true
+			funcs = append(funcs, other)
+		}
+	}
+
+	sort.Slice(funcs, func(i, j int) bool {
+		return label(funcs[i]) < label(funcs[j])
+	})
+
+	return funcs
+}
+
+func (mg *moduleGraph) list(
+	fn *ssa.Function, callers bool, maxDepth int,
+) string {
+	var (
+		res  strings.Builder
+		path = make(map[*ssa.Function]bool)
+		walk func(fn *ssa.Function, depth int)
+	)
+
+	walk = func(fn *ssa.Function, depth int) {
+		indent := strings.Repeat(listIndent, depth)
+
+		if path[fn] {
+			res.WriteString(indent + label(fn) + recursiveMark + "\n")
+
+			return
+		}
+
+		res.WriteString(indent + label(fn) + "\n")
+
+		if depth >= maxDepth {
+			return
+		}
+
+		path[fn] = true
+
+		for _, other := range mg.related(fn, callers) {
+			walk(other, depth+1)
+		}
+
+		delete(path, fn)
+	}
+
+	walk(fn, 0)
+
+	return res.String()
+}
+
+func (mg *moduleGraph) mermaid(
+	fn *ssa.Function, callers bool, maxDepth int,
+) string {
+	var (
+		res   strings.Builder
+		ids   = map[*ssa.Function]string{fn: "n0"}
+		nodes = []*ssa.Function{fn}
+		edges []string
+		depth = map[*ssa.Function]int{fn: 0}
+	)
+
+	for i := 0; i < len(nodes); i++ {
+		current := nodes[i]
+		if depth[current] >= maxDepth {
+			continue
+		}
+
+		for _, other := range mg.related(current, callers) {
+			if _, ok := ids[other]; !ok {
+				ids[other] = "n" + strconv.Itoa(len(nodes))
+				depth[other] = depth[current] + 1
+				nodes = append(nodes, other)
+			}
+
+			if callers {
+				edges = append(edges, ids[other]+" --> "+ids[current])
+			} else {
+				edges = append(edges, ids[current]+" --> "+ids[other])
+			}
+		}
+	}
+
+	res.WriteString("flowchart LR\n")
+
+	for _, n := range nodes {
+		res.WriteString("    " + ids[n] + "[\"" + label(n) + "\"]\n")
+	}
+
+	for _, e := range edges {
+		res.WriteString("    " + e + "\n")
+	}
+
+	return res.String()
+}
+
+type excerpt struct {
+	depth   int
+	callers bool
+	format  string
+}
+
+func parseOptions(opts cmds.Options) (excerpt, error) {
+	var (
+		ex        excerpt
+		direction = opts.Value(optDirection, directionCallees)
+		err       error
+	)
+
+	ex.format = opts.Value(optFormat, formatMermaid)
+	ex.callers = direction == directionCallers
+	ex.depth, err = opts.Int(optDepth, defaultDepth)
+
+	if err == nil && ex.depth < 1 {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optDepth, opts[optDepth],
+		)
+	}
+
+	if err == nil &&
+		direction != directionCallees && direction != directionCallers {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optDirection, direction,
+		)
+	}
+
+	if err == nil && ex.format != formatMermaid && ex.format != formatList {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optFormat, ex.format,
+		)
+	}
+
+	return ex, err
+}
+
+// GetCallGraph renders the functions called by (or calling) the function or
+// method ("Type.Method") named in the relative package directory.
+func GetCallGraph(cmd string) (string, error) {
+	var (
+		dirs    []string
+		actions []string
+		opts    cmds.Options
+		ex      excerpt
+		mg      *moduleGraph
+		fn      *ssa.Function
+		res     string
+		err     error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(
+		cmd, optDepth, optDirection, optFormat,
+	)
+
+	if err == nil {
+		ex, err = parseOptions(opts)
+	}
+
+	if err == nil {
+		dirs, actions, err = cmds.ParseCmds(cmd)
+	}
+
+	for i, mi := 0, len(dirs); i < mi && err == nil; i++ {
+		mg, err = getModuleGraph(dirs[i])
+
+		if err == nil {
+			fn, err = mg.lookup(dirs[i], actions[i])
+		}
+
+		if err == nil {
+			if res != "" {
+				res += "\n\n"
+			}
+
+			if ex.format == formatList {
+				res += format.Inline("text", mg.list(fn, ex.callers, ex.depth))
+			} else {
+				res += format.Inline(
+					formatMermaid, mg.mermaid(fn, ex.callers, ex.depth),
+				)
+			}
+		}
+	}
+
+	if err == nil {
+		return res, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
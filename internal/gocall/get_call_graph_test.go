@@ -0,0 +1,186 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gocall_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gocall"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupModule(t *testing.T, chk *sztest.Chk) string {
+	t.Helper()
+
+	gocall.Reset()
+
+	return testmod.Setup(t, chk, "app")
+}
+
+func Test_GetCallGraph_Callees(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	format.ForMarkdown()
+
+	dir := setupModule(t, chk)
+
+	s, err := gocall.GetCallGraph("./Main")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("mermaid", ""+
+			"flowchart LR\n"+
+			"    n0[\"app.Main\"]\n"+
+			"    n1[\"lib.(*Worker).Work\"]\n"+
+			"    n2[\"lib.Parse\"]\n"+
+			"    n3[\"lib.clean\"]\n"+
+			"    n0 --> n1\n"+
+			"    n0 --> n2\n"+
+			"    n1 --> n1\n"+
+			"    n1 --> n3\n"+
+			"    n2 --> n3\n",
+		),
+	)
+
+	s, err = gocall.GetCallGraph("./Main format=list depth=3")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("text", ""+
+			"app.Main\n"+
+			"    lib.(*Worker).Work\n"+
+			"        lib.(*Worker).Work (recursive)\n"+
+			"        lib.clean\n"+
+			"    lib.Parse\n"+
+			"        lib.clean\n",
+		),
+	)
+
+	chk.Stdout(
+		"Building call graph for: " + dir,
+	)
+}
+
+func Test_GetCallGraph_Callers(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	format.ForMarkdown()
+
+	dir := setupModule(t, chk)
+
+	s, err := gocall.GetCallGraph(
+		"./lib/clean direction=callers depth=1 format=list",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("text", ""+
+			"lib.clean\n"+
+			"    lib.(*Worker).Work\n"+
+			"    lib.Parse\n",
+		),
+	)
+
+	s, err = gocall.GetCallGraph("./lib/Parse direction=callers")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("mermaid", ""+
+			"flowchart LR\n"+
+			"    n0[\"lib.Parse\"]\n"+
+			"    n1[\"app.Main\"]\n"+
+			"    n1 --> n0\n",
+		),
+	)
+
+	chk.Stdout(
+		"Building call graph for: " + dir,
+	)
+}
+
+func Test_GetCallGraph_Errors(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	dir := setupModule(t, chk)
+
+	_, err := gocall.GetCallGraph("./Main depth=0")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"depth=\"0\"",
+		),
+	)
+
+	_, err = gocall.GetCallGraph("./Main direction=up")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"direction=\"up\"",
+		),
+	)
+
+	_, err = gocall.GetCallGraph("./Main format=svg")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"format=\"svg\"",
+		),
+	)
+
+	_, err = gocall.GetCallGraph("./DoesNotExist")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrUnknownObject,
+			"DoesNotExist",
+		),
+	)
+
+	_, err = gocall.GetCallGraph("./lib/Worker.DoesNotExist")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrUnknownObject,
+			"Worker.DoesNotExist",
+		),
+	)
+
+	_, err = gocall.GetCallGraph("./lib/Unknown.Method")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrUnknownObject,
+			"Unknown.Method",
+		),
+	)
+
+	chk.Stdout(
+		"Building call graph for: " + dir,
+	)
+}
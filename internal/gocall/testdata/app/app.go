@@ -0,0 +1,14 @@
+package app
+
+import (
+	"fmt"
+
+	"example.com/app/lib"
+)
+
+// Main is the entry point.
+func Main() {
+	fmt.Println(lib.Parse("x"))
+	var w lib.Worker
+	w.Work(3)
+}
@@ -0,0 +1,17 @@
+package lib
+
+// Parse parses.
+func Parse(s string) string { return clean(s) }
+
+func clean(s string) string { return s }
+
+// Worker works.
+type Worker struct{}
+
+// Work works recursively.
+func (w *Worker) Work(n int) {
+	if n > 0 {
+		w.Work(n - 1)
+	}
+	_ = clean("")
+}
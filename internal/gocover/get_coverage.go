@@ -0,0 +1,534 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gocover
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/szlog"
+	"golang.org/x/tools/cover"
+)
+
+// Options controlling the report.
+const (
+	optMin     = "min"
+	optFuncMin = "func-min"
+	optSort    = "sort"
+	optTimeout = "timeout"
+)
+
+// Orders the functions may be sorted in.
+const (
+	sortFile     = "file"
+	sortName     = "name"
+	sortCoverage = "coverage"
+)
+
+//nolint:goCheckNoGlobals // Ok.
+var sortOrders = []string{sortFile, sortName, sortCoverage}
+
+const maxPercent = 100
+
+// funcCoverage holds the statement coverage of a single function.
+type funcCoverage struct {
+	name    string
+	file    string
+	line    int
+	covered int
+	total   int
+}
+
+// percent returns the percentage of statements covered.  As with go tool
+// cover a function without statements is reported as 0%.
+func (f *funcCoverage) percent() float64 {
+	return percent(f.covered, f.total)
+}
+
+func percent(covered, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(covered) * maxPercent / float64(total)
+}
+
+func percentStr(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
+
+type coverOptions struct {
+	minimum int
+	funcMin int
+	order   string
+	build   gopkg.Build
+	timeout time.Duration
+}
+
+type report struct {
+	funcs   []*funcCoverage
+	covered int
+	total   int
+}
+
+func (r *report) percent() float64 {
+	return percent(r.covered, r.total)
+}
+
+func (r *report) sort(order string) {
+	less := func(a, b *funcCoverage) bool {
+		if a.file != b.file {
+			return a.file < b.file
+		}
+
+		return a.line < b.line
+	}
+
+	sort.SliceStable(r.funcs, func(i, j int) bool {
+		a, b := r.funcs[i], r.funcs[j]
+
+		switch {
+		case order == sortName && a.name != b.name:
+			return a.name < b.name
+		case order == sortCoverage && a.percent() != b.percent():
+			return a.percent() < b.percent()
+		default:
+			return less(a, b)
+		}
+	})
+}
+
+func (r *report) table() string {
+	var res strings.Builder
+
+	res.WriteString("| function | location | statements | coverage |\n")
+	res.WriteString("| -------- | -------- | ---------: | -------: |\n")
+
+	for _, f := range r.funcs {
+		res.WriteString(fmt.Sprintf("| `%s` | `%s:%d` | %d/%d | %s |\n",
+			f.name, f.file, f.line, f.covered, f.total,
+			percentStr(f.percent()),
+		))
+	}
+
+	res.WriteString(fmt.Sprintf("| **total** | | %d/%d | %s |\n",
+		r.covered, r.total, percentStr(r.percent()),
+	))
+
+	return res.String()
+}
+
+// below returns the functions (with statements) covered less than the
+// minimum percentage.
+func (r *report) below(minimum int) []string {
+	var lines []string
+
+	for _, f := range r.funcs {
+		if f.total > 0 && f.percent() < float64(minimum) {
+			lines = append(lines, fmt.Sprintf("%s:%d: %s %s",
+				f.file, f.line, f.name, percentStr(f.percent()),
+			))
+		}
+	}
+
+	return lines
+}
+
+func parseCoverageCmd(
+	cmd string,
+) ([]string, []string, *coverOptions, error) {
+	var (
+		dirs     []string
+		actions  []string
+		opts     cmds.Options
+		coverOpt = new(coverOptions)
+		err      error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(
+		cmd, gopkg.BuildOptions(optMin, optFuncMin, optSort, optTimeout)...,
+	)
+
+	if err == nil {
+		coverOpt.build = gopkg.BuildFromOptions(opts)
+		coverOpt.minimum, err = opts.Int(optMin, 0)
+	}
+
+	if err == nil && (coverOpt.minimum < 0 || coverOpt.minimum > maxPercent) {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optMin, opts[optMin],
+		)
+	}
+
+	if err == nil {
+		coverOpt.funcMin, err = opts.Int(optFuncMin, 0)
+	}
+
+	if err == nil && (coverOpt.funcMin < 0 || coverOpt.funcMin > maxPercent) {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optFuncMin, opts[optFuncMin],
+		)
+	}
+
+	if err == nil {
+		coverOpt.order = opts.Value(optSort, sortFile)
+		if !slices.Contains(sortOrders, coverOpt.order) {
+			err = fmt.Errorf(
+				"%w: %s=%q", errs.ErrInvalidOption, optSort, coverOpt.order,
+			)
+		}
+	}
+
+	if err == nil {
+		coverOpt.timeout, err = opts.Duration(optTimeout, proc.Timeout())
+	}
+
+	if err == nil {
+		dirs, actions, err = cmds.ParseCmds(cmd)
+	}
+
+	for i, mi := 0, len(actions); i < mi && err == nil; i++ {
+		if actions[i] != "." && actions[i] != "..." {
+			err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, actions[i])
+		}
+	}
+
+	if err == nil {
+		return dirs, actions, coverOpt, nil
+	}
+
+	return nil, nil, nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// recvName returns the receiver of a method as go tool cover reports it
+// ("T" or "(*T)") dropping any type parameters.
+func recvName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return "(*" + recvName(e.X) + ")"
+	case *ast.IndexExpr:
+		return recvName(e.X)
+	case *ast.IndexListExpr:
+		return recvName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
+
+// funcExtent is the source range of a function declaration.
+type funcExtent struct {
+	name      string
+	startLine int
+	startCol  int
+	endLine   int
+	endCol    int
+}
+
+func funcExtents(fName string) ([]*funcExtent, error) {
+	fSet := token.NewFileSet()
+
+	file, err := parser.ParseFile(
+		fSet, fName, nil, parser.SkipObjectResolution,
+	)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	var extents []*funcExtent
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		name := fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			name = recvName(fn.Recv.List[0].Type) + "." + name
+		}
+
+		start := fSet.Position(fn.Pos())
+		end := fSet.Position(fn.End())
+		extents = append(extents, &funcExtent{
+			name:      name,
+			startLine: start.Line,
+			startCol:  start.Column,
+			endLine:   end.Line,
+			endCol:    end.Column,
+		})
+	}
+
+	return extents, nil
+}
+
+// coverage totals the profile blocks falling within the function in the
+// same way as go tool cover -func.
+func (e *funcExtent) coverage(blocks []cover.ProfileBlock) (int, int) {
+	var covered, total int
+
+	for _, b := range blocks {
+		if b.StartLine > e.endLine ||
+			(b.StartLine == e.endLine && b.StartCol >= e.endCol) {
+			break
+		}
+
+		if b.EndLine < e.startLine ||
+			(b.EndLine == e.startLine && b.EndCol <= e.startCol) {
+			continue
+		}
+
+		total += b.NumStmt
+		if b.Count > 0 {
+			covered += b.NumStmt
+		}
+	}
+
+	return covered, total
+}
+
+// testsFailed reports the normalized output of the failing tests.
+func testsFailed(pattern, out string) error {
+	out, err := normalize.String(out)
+	if err != nil {
+		return err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	return fmt.Errorf("%w: go test -coverprofile %s\n%s",
+		errs.ErrTestsFailed, pattern, strings.TrimRight(out, "\n"),
+	)
+}
+
+// profile runs the tests of the packages matching the pattern returning
+// their parsed coverage profile.
+func profile(
+	runDir string, wsEnv []string, pattern string, opts *coverOptions,
+) ([]*cover.Profile, error) {
+	var (
+		tmp      *os.File
+		testArg  []string
+		out      []byte
+		profiles []*cover.Profile
+		err      error
+	)
+
+	tmp, err = os.CreateTemp("", "gotomd-cover-*.out")
+	if err == nil {
+		defer func() {
+			_ = os.Remove(tmp.Name())
+		}()
+
+		err = tmp.Close()
+	}
+
+	if err == nil {
+		testArg = append([]string{"test"}, opts.build.Flags()...)
+		testArg = append(testArg, "-coverprofile="+tmp.Name(), pattern)
+
+		c := proc.Command(opts.timeout, "go", testArg...)
+		c.Dir = runDir
+		c.Env = append(append(os.Environ(), wsEnv...), opts.build.Env()...)
+
+		out, err = c.CombinedOutput()
+		if err != nil && !proc.Stopped(err) {
+			err = testsFailed(pattern, string(out))
+		}
+	}
+
+	if err == nil {
+		profiles, err = cover.ParseProfiles(tmp.Name())
+	}
+
+	if err == nil {
+		return profiles, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// addProfiles adds the functions of each profiled file to the report.
+func (r *report) addProfiles(
+	profiles []*cover.Profile, dirs map[string]string,
+) error {
+	var (
+		fName   string
+		extents []*funcExtent
+		err     error
+	)
+
+	for i, mi := 0, len(profiles); i < mi && err == nil; i++ {
+		fName = profiles[i].FileName
+
+		dir, found := dirs[path.Dir(fName)]
+		if found {
+			fName = filepath.Join(dir, path.Base(fName))
+		}
+
+		extents, err = funcExtents(fName)
+
+		if err == nil {
+			fName, err = gowork.Relative(fName)
+		}
+
+		for j, mj := 0, len(extents); j < mj && err == nil; j++ {
+			covered, total := extents[j].coverage(profiles[i].Blocks)
+			r.funcs = append(r.funcs, &funcCoverage{
+				name:    extents[j].name,
+				file:    fName,
+				line:    extents[j].startLine,
+				covered: covered,
+				total:   total,
+			})
+			r.covered += covered
+			r.total += total
+		}
+	}
+
+	return err
+}
+
+func buildReport(dirs, actions []string, opts *coverOptions) (*report, error) {
+	var (
+		rpt      = new(report)
+		runDir   string
+		relDir   string
+		wsEnv    []string
+		pkgDirs  map[string]string
+		profiles []*cover.Profile
+		err      error
+	)
+
+	for i, mi := 0, len(dirs); i < mi && err == nil; i++ {
+		runDir, relDir, wsEnv, err = gowork.Target(dirs[i])
+
+		pattern := relDir
+		if actions[i] == "..." {
+			pattern = relDir + string(os.PathSeparator) + actions[i]
+		}
+
+		if err == nil {
+			pkgDirs, err = gopkg.ImportDirs(dirs[i], actions[i], opts.build)
+		}
+
+		if err == nil {
+			profiles, err = profile(runDir, wsEnv, pattern, opts)
+		}
+
+		if err == nil {
+			err = rpt.addProfiles(profiles, pkgDirs)
+		}
+	}
+
+	if err == nil {
+		return rpt, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// Total runs the tests of the package in the directory (action ".") or of
+// every package below it (action "...") for the build returning their
+// overall statement coverage percentage.
+func Total(
+	dir, action string, build gopkg.Build, timeout time.Duration,
+) (float64, error) {
+	rpt, err := buildReport([]string{dir}, []string{action}, &coverOptions{
+		minimum: 0,
+		funcMin: 0,
+		order:   sortFile,
+		build:   build,
+		timeout: timeout,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return rpt.percent(), nil
+}
+
+// GetCoverage runs the tests of the packages in the relative directories
+// ("./dir/." for a single package or "./dir/..." recursively) reporting
+// the statement coverage of each function (as go tool cover -func does) as
+// a table with an overall total.  An error is returned if the total or any
+// function falls below the requested minimum percentages.
+func GetCoverage(cmd string) (string, error) {
+	var (
+		dirs    []string
+		actions []string
+		opts    *coverOptions
+		rpt     *report
+		below   []string
+		err     error
+	)
+
+	dirs, actions, opts, err = parseCoverageCmd(cmd)
+
+	if err == nil {
+		rpt, err = buildReport(dirs, actions, opts)
+	}
+
+	if err == nil {
+		rpt.sort(opts.order)
+
+		below = rpt.below(opts.funcMin)
+		if len(below) > 0 {
+			for _, line := range below {
+				szlog.Say0(line, "\n")
+			}
+
+			err = fmt.Errorf("%w: %d functions < %d%%",
+				errs.ErrCoverage, len(below), opts.funcMin,
+			)
+		}
+	}
+
+	if err == nil && rpt.percent() < float64(opts.minimum) {
+		err = fmt.Errorf("%w: total %s < %d%%",
+			errs.ErrCoverage, percentStr(rpt.percent()), opts.minimum,
+		)
+	}
+
+	if err == nil {
+		if format.IsForMarkdown() {
+			return strings.TrimRight(rpt.table(), "\n"), nil
+		}
+
+		return format.Inline("text", rpt.table()), nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
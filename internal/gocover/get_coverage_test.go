@@ -0,0 +1,150 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gocover_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gocover"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GetCoverage_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	_, err := gocover.GetCoverage("./. min=101")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: min="101"`)
+
+	_, err = gocover.GetCoverage("./. func-min=-1")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: func-min="-1"`)
+
+	_, err = gocover.GetCoverage("./. sort=size")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: sort="size"`)
+
+	_, err = gocover.GetCoverage("./Sign")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "Sign"`)
+}
+
+func Test_GetCoverage(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	res, err := gocover.GetCoverage("./... min=40")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| function | location | statements | coverage |\n"+
+		"| -------- | -------- | ---------: | -------: |\n"+
+		"| `Sign` | `./app.go:5` | 2/3 | 66.7% |\n"+
+		"| `(*T).Name` | `./app.go:13` | 0/1 | 0.0% |\n"+
+		"| `Sub` | `./sub/sub.go:3` | 0/1 | 0.0% |\n"+
+		"| **total** | | 2/5 | 40.0% |",
+	)
+
+	res, err = gocover.GetCoverage("./. sort=name")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| function | location | statements | coverage |\n"+
+		"| -------- | -------- | ---------: | -------: |\n"+
+		"| `(*T).Name` | `./app.go:13` | 0/1 | 0.0% |\n"+
+		"| `Sign` | `./app.go:5` | 2/3 | 66.7% |\n"+
+		"| **total** | | 2/4 | 50.0% |",
+	)
+
+	res, err = gocover.GetCoverage("./. sort=coverage")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| function | location | statements | coverage |\n"+
+		"| -------- | -------- | ---------: | -------: |\n"+
+		"| `(*T).Name` | `./app.go:13` | 0/1 | 0.0% |\n"+
+		"| `Sign` | `./app.go:5` | 2/3 | 66.7% |\n"+
+		"| **total** | | 2/4 | 50.0% |",
+	)
+}
+
+func Test_GetCoverage_Tags(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	res, err := gocover.GetCoverage("./... tags=extra")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| function | location | statements | coverage |\n"+
+		"| -------- | -------- | ---------: | -------: |\n"+
+		"| `Sign` | `./app.go:5` | 2/3 | 66.7% |\n"+
+		"| `(*T).Name` | `./app.go:13` | 0/1 | 0.0% |\n"+
+		"| `Sub` | `./sub/sub.go:3` | 0/1 | 0.0% |\n"+
+		"| `Tagged` | `./tagged/tagged.go:5` | 2/3 | 66.7% |\n"+
+		"| **total** | | 4/8 | 50.0% |",
+	)
+
+	_, err = gocover.GetCoverage("./tagged")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "tagged"`)
+}
+
+func Test_GetCoverage_Thresholds(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	_, err := gocover.GetCoverage("./... min=50")
+	chk.Err(err, errs.ErrCoverage.Error()+": total 40.0% < 50%")
+
+	_, err = gocover.GetCoverage("./. func-min=50")
+	chk.Err(err, errs.ErrCoverage.Error()+": 1 functions < 50%")
+
+	chk.Stdout(
+		"./app.go:13: (*T).Name 0.0%",
+	)
+}
+
+func Test_GetCoverage_TestsFailed(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	_ = chk.CreateTmpFileAs(chk.CreateTmpDir(), "fail_test.go", []byte(""+
+		"package app\n\n"+
+		"import \"testing\"\n\n"+
+		"func TestFail(t *testing.T) {\n"+
+		"\tt.Fatal(\"broken\")\n"+
+		"}\n",
+	))
+
+	_, err := gocover.GetCoverage("./.")
+	chk.Err(err, ""+
+		errs.ErrTestsFailed.Error()+": go test -coverprofile .\n"+
+		"--- FAIL: TestFail (0.0s)\n"+
+		"    fail_test.go:6: broken\n"+
+		"FAIL\n"+
+		"coverage: 50.0% of statements\n"+
+		"FAIL\texample.com/app\t0.0s\n"+
+		"FAIL",
+	)
+}
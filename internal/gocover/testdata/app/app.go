@@ -0,0 +1,15 @@
+package app
+
+type T struct{}
+
+func Sign(n int) int {
+	if n < 0 {
+		return -1
+	}
+
+	return 1
+}
+
+func (*T) Name() string {
+	return "t"
+}
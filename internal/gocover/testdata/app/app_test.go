@@ -0,0 +1,9 @@
+package app
+
+import "testing"
+
+func TestSign(t *testing.T) {
+	if Sign(1) != 1 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,5 @@
+package sub
+
+func Sub() int {
+	return 1
+}
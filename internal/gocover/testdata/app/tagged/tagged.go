@@ -0,0 +1,11 @@
+//go:build extra
+
+package tagged
+
+func Tagged(n int) int {
+	if n < 0 {
+		return 0
+	}
+
+	return n
+}
@@ -0,0 +1,11 @@
+//go:build extra
+
+package tagged
+
+import "testing"
+
+func TestTagged(t *testing.T) {
+	if Tagged(1) != 1 {
+		t.Fail()
+	}
+}
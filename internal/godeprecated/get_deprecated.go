@@ -0,0 +1,128 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godeprecated
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+)
+
+const noDeprecated = "No deprecated identifiers."
+
+func parseDeprecatedCmd(
+	cmd string,
+) ([]string, []string, gopkg.Build, error) {
+	var (
+		dirs    []string
+		actions []string
+		opts    cmds.Options
+		build   gopkg.Build
+		err     error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(cmd, gopkg.BuildOptions()...)
+
+	if err == nil {
+		build = gopkg.BuildFromOptions(opts)
+		dirs, actions, err = cmds.ParseCmds(cmd)
+	}
+
+	for i, mi := 0, len(actions); i < mi && err == nil; i++ {
+		if actions[i] != "." && actions[i] != "..." {
+			err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, actions[i])
+		}
+	}
+
+	if err == nil {
+		return dirs, actions, build, nil
+	}
+
+	return nil, nil, build, err //nolint:wrapcheck // Ok.
+}
+
+func tableCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+func tableRow(dir string, d gopkg.Deprecation) string {
+	replacement := ""
+	if d.Replacement != "" {
+		replacement = "[`" + d.Replacement + "`](" + dir + "/" +
+			d.ReplacementFile + "#L" + strconv.Itoa(d.ReplacementLine) + ")"
+	}
+
+	return "| `" + dir + "` | `" + d.Name + "` | " +
+		tableCell(d.Note) + " | " + replacement + " |\n"
+}
+
+// GetDeprecated renders a table of all exported identifiers documented with
+// a "Deprecated:" paragraph in the packages of the relative directories
+// ("./dir/." for a single package or "./dir/..." recursively).  Each row
+// lists the identifier, its deprecation note and a link to its replacement
+// when it can be resolved.
+func GetDeprecated(cmd string) (string, error) {
+	var (
+		dirs    []string
+		actions []string
+		build   gopkg.Build
+		pkgDirs []string
+		list    []gopkg.Deprecation
+		rows    strings.Builder
+		err     error
+	)
+
+	dirs, actions, build, err = parseDeprecatedCmd(cmd)
+
+	for i, mi := 0, len(dirs); i < mi && err == nil; i++ {
+		pkgDirs, err = gopkg.PackageDirs(dirs[i], actions[i], build)
+
+		for j, mj := 0, len(pkgDirs); j < mj && err == nil; j++ {
+			_, list, err = gopkg.Deprecations(pkgDirs[j], build)
+
+			for _, d := range list {
+				rows.WriteString(tableRow(pkgDirs[j], d))
+			}
+		}
+	}
+
+	if err != nil {
+		return "", err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	if rows.Len() == 0 {
+		return noDeprecated, nil
+	}
+
+	table := "" +
+		"| package | identifier | deprecation | replacement |\n" +
+		"| ------- | ---------- | ----------- | ----------- |\n" +
+		rows.String()
+
+	if format.IsForMarkdown() {
+		return strings.TrimRight(table, "\n"), nil
+	}
+
+	return format.Inline("text", table), nil
+}
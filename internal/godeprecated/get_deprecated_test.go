@@ -0,0 +1,91 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godeprecated_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/godeprecated"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupModule(t *testing.T, chk *sztest.Chk) {
+	t.Helper()
+
+	_ = testmod.Setup(t, chk, "app")
+	testmod.Args(chk)
+	chk.PushPostReleaseFunc(func() error {
+		format.ForMarkdown()
+
+		return nil
+	})
+
+	gopkg.Reset()
+	format.ForMarkdown()
+}
+
+func Test_GetDeprecated_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	_, err := godeprecated.GetDeprecated("./sub/Old")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "Old"`)
+
+	_, err = godeprecated.GetDeprecated("./sub/. tags=a tags=b")
+	chk.Err(err, chk.ErrChain(errs.ErrDuplicateOption, `"tags"`))
+
+	chk.Stdout()
+}
+
+func Test_GetDeprecated(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	table := "" +
+		"| package | identifier | deprecation | replacement |\n" +
+		"| ------- | ---------- | ----------- | ----------- |\n" +
+		"| `./sub` | `Old` | Use [New] instead. | " +
+		"[`New`](./sub/sub.go#L5) |\n" +
+		"| `./sub` | `Older` | No replacement \\| sorry. |  |"
+
+	res, err := godeprecated.GetDeprecated("./...")
+	chk.NoErr(err)
+	chk.Str(res, table)
+
+	res, err = godeprecated.GetDeprecated("./.")
+	chk.NoErr(err)
+	chk.Str(res, "No deprecated identifiers.")
+
+	format.ForGoDoc()
+
+	res, err = godeprecated.GetDeprecated("./sub/.")
+	chk.NoErr(err)
+	chk.Str(res, format.Inline("text", table))
+
+	chk.Stdout()
+}
@@ -0,0 +1,5 @@
+// Package app is current.
+package app
+
+// Run is current.
+func Run() {}
@@ -0,0 +1,15 @@
+// Package sub is partially deprecated.
+package sub
+
+// New is current.
+func New() {}
+
+// Old is old.
+//
+// Deprecated: Use [New] instead.
+func Old() {}
+
+// Older is older.
+//
+// Deprecated: No replacement | sorry.
+func Older() {}
@@ -0,0 +1,335 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godeps
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/szlog"
+	"golang.org/x/tools/go/packages"
+)
+
+// Options controlling the graph.
+const (
+	optInternal = "internal"
+	optStd      = "std"
+	optDepth    = "depth"
+	optFormat   = "format"
+)
+
+// Supported output formats.
+const (
+	formatMermaid = "mermaid"
+	formatDot     = "dot"
+)
+
+const recursiveAction = "..."
+
+type graphFilter struct {
+	internalOnly bool
+	includeStd   bool
+	maxDepth     int
+}
+
+// graph holds the packages (nodes) and their imports (edges) in the order
+// they should be rendered.
+type graph struct {
+	modulePaths []string
+	nodes       []string
+	edges       [][2]string
+}
+
+func (f graphFilter) include(pkg *packages.Package) bool {
+	isStd := pkg.Module == nil
+	if isStd {
+		return f.includeStd && !f.internalOnly
+	}
+
+	return !f.internalOnly || pkg.Module.Main
+}
+
+func loadPackages(dir, action string) ([]*packages.Package, error) {
+	var (
+		modDir  string
+		relDir  string
+		wsEnv   []string
+		pkgs    []*packages.Package
+		pattern string
+		err     error
+	)
+
+	modDir, relDir, err = gowork.Locate(dir)
+	if err == nil {
+		wsEnv, err = gowork.Env(dir)
+	}
+
+	if err == nil {
+		pattern = relDir
+		if action == recursiveAction {
+			pattern = relDir + string(os.PathSeparator) + recursiveAction
+		}
+
+		szlog.Say1("Loading package imports for: ", pattern, "\n")
+
+		cfg := new(packages.Config)
+		cfg.Dir = modDir
+		cfg.Env = append(os.Environ(), wsEnv...)
+		cfg.Mode = packages.NeedName |
+			packages.NeedImports |
+			packages.NeedDeps |
+			packages.NeedModule
+		cfg.Tests = false
+
+		pkgs, err = proc.LoadPackages(cfg, pattern)
+	}
+
+	if err == nil && len(pkgs) == 0 {
+		err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, pattern)
+	}
+
+	for i, mi := 0, len(pkgs); i < mi && err == nil; i++ {
+		if len(pkgs[i].Errors) > 0 {
+			err = fmt.Errorf(
+				"%w: %q: %v",
+				errs.ErrInvalidPackage, pattern, pkgs[i].Errors[0],
+			)
+		}
+	}
+
+	if err == nil {
+		return pkgs, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// buildGraph walks the imports breadth first from the root packages
+// recording all nodes and edges passing the filter.
+func buildGraph(roots []*packages.Package, filter graphFilter) *graph {
+	var (
+		g       = new(graph)
+		depth   = make(map[string]int)
+		modules = make(map[string]bool)
+		queue   []*packages.Package
+	)
+
+	for _, pkg := range roots {
+		if _, ok := depth[pkg.PkgPath]; !ok && filter.include(pkg) {
+			depth[pkg.PkgPath] = 0
+			queue = append(queue, pkg)
+		}
+	}
+
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+
+		g.nodes = append(g.nodes, pkg.PkgPath)
+
+		if pkg.Module != nil && pkg.Module.Main && !modules[pkg.Module.Path] {
+			modules[pkg.Module.Path] = true
+			g.modulePaths = append(g.modulePaths, pkg.Module.Path)
+		}
+
+		if filter.maxDepth > 0 && depth[pkg.PkgPath] >= filter.maxDepth {
+			continue
+		}
+
+		importPaths := make([]string, 0, len(pkg.Imports))
+		for path := range pkg.Imports {
+			importPaths = append(importPaths, path)
+		}
+
+		sort.Strings(importPaths)
+
+		for _, path := range importPaths {
+			imp := pkg.Imports[path]
+			if !filter.include(imp) {
+				continue
+			}
+
+			g.edges = append(g.edges, [2]string{pkg.PkgPath, imp.PkgPath})
+
+			if _, ok := depth[imp.PkgPath]; !ok {
+				depth[imp.PkgPath] = depth[pkg.PkgPath] + 1
+				queue = append(queue, imp)
+			}
+		}
+	}
+
+	sort.Strings(g.nodes)
+	sort.Slice(g.edges, func(i, j int) bool {
+		if g.edges[i][0] == g.edges[j][0] {
+			return g.edges[i][1] < g.edges[j][1]
+		}
+
+		return g.edges[i][0] < g.edges[j][0]
+	})
+
+	return g
+}
+
+// label shortens packages belonging to a main module to their path within
+// the module.
+func (g *graph) label(pkgPath string) string {
+	for _, modPath := range g.modulePaths {
+		if rel, ok := strings.CutPrefix(pkgPath, modPath+"/"); ok {
+			return rel
+		}
+	}
+
+	return pkgPath
+}
+
+func (g *graph) ids() map[string]string {
+	ids := make(map[string]string, len(g.nodes))
+	for i, n := range g.nodes {
+		ids[n] = "n" + strconv.Itoa(i)
+	}
+
+	return ids
+}
+
+func (g *graph) mermaid() string {
+	var res strings.Builder
+
+	ids := g.ids()
+
+	res.WriteString("graph LR\n")
+
+	for _, n := range g.nodes {
+		res.WriteString("    " + ids[n] + "[\"" + g.label(n) + "\"]\n")
+	}
+
+	for _, e := range g.edges {
+		res.WriteString("    " + ids[e[0]] + " --> " + ids[e[1]] + "\n")
+	}
+
+	return res.String()
+}
+
+func (g *graph) dot() string {
+	var res strings.Builder
+
+	res.WriteString("digraph imports {\n")
+	res.WriteString("    rankdir=LR;\n")
+
+	for _, n := range g.nodes {
+		res.WriteString("    " + strconv.Quote(g.label(n)) + ";\n")
+	}
+
+	for _, e := range g.edges {
+		res.WriteString("    " +
+			strconv.Quote(g.label(e[0])) + " -> " +
+			strconv.Quote(g.label(e[1])) + ";\n",
+		)
+	}
+
+	res.WriteString("}\n")
+
+	return res.String()
+}
+
+func parseFilter(opts cmds.Options) (graphFilter, string, error) {
+	var (
+		filter graphFilter
+		fmtStr = opts.Value(optFormat, formatMermaid)
+		err    error
+	)
+
+	filter.internalOnly, err = opts.Bool(optInternal, false)
+
+	if err == nil {
+		filter.includeStd, err = opts.Bool(optStd, true)
+	}
+
+	if err == nil {
+		filter.maxDepth, err = opts.Int(optDepth, 0)
+	}
+
+	if err == nil && filter.maxDepth < 0 {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optDepth, opts[optDepth],
+		)
+	}
+
+	if err == nil && fmtStr != formatMermaid && fmtStr != formatDot {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optFormat, fmtStr,
+		)
+	}
+
+	return filter, fmtStr, err
+}
+
+// GetDeps renders the import graph of the packages matching the relative
+// directory ("./dir/." for a single package or "./dir/..." recursively).
+func GetDeps(cmd string) (string, error) {
+	var (
+		dir    string
+		action string
+		opts   cmds.Options
+		filter graphFilter
+		fmtStr string
+		pkgs   []*packages.Package
+		err    error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(
+		cmd, optInternal, optStd, optDepth, optFormat,
+	)
+
+	if err == nil {
+		filter, fmtStr, err = parseFilter(opts)
+	}
+
+	if err == nil {
+		dir, action, err = cmds.ParseCmd(cmd)
+	}
+
+	if err == nil && action != "." && action != recursiveAction {
+		err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, action)
+	}
+
+	if err == nil {
+		pkgs, err = loadPackages(filepath.Clean(dir), action)
+	}
+
+	if err == nil {
+		g := buildGraph(pkgs, filter)
+
+		if fmtStr == formatDot {
+			return format.Inline(formatDot, g.dot()), nil
+		}
+
+		return format.Inline(formatMermaid, g.mermaid()), nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
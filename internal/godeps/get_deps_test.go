@@ -0,0 +1,165 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godeps_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/godeps"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GetDeps_Internal(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	format.ForMarkdown()
+	testmod.Setup(t, chk, "app")
+
+	s, err := godeps.GetDeps("./... internal=true")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("mermaid", ""+
+			"graph LR\n"+
+			"    n0[\"example.com/app\"]\n"+
+			"    n1[\"internal/one\"]\n"+
+			"    n2[\"internal/two\"]\n"+
+			"    n0 --> n1\n"+
+			"    n1 --> n2\n",
+		),
+	)
+
+	s, err = godeps.GetDeps("./. internal=true depth=1 format=dot")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("dot", ""+
+			"digraph imports {\n"+
+			"    rankdir=LR;\n"+
+			"    \"example.com/app\";\n"+
+			"    \"internal/one\";\n"+
+			"    \"example.com/app\" -> \"internal/one\";\n"+
+			"}\n",
+		),
+	)
+
+	chk.Stdout(
+		"Loading package imports for: ./...",
+		"Loading package imports for: .",
+	)
+}
+
+func Test_GetDeps_Std(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	format.ForMarkdown()
+	testmod.Setup(t, chk, "app")
+
+	s, err := godeps.GetDeps("./internal/... depth=1")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("mermaid", ""+
+			"graph LR\n"+
+			"    n0[\"internal/one\"]\n"+
+			"    n1[\"internal/two\"]\n"+
+			"    n2[\"os\"]\n"+
+			"    n3[\"strings\"]\n"+
+			"    n0 --> n1\n"+
+			"    n0 --> n3\n"+
+			"    n1 --> n2\n",
+		),
+	)
+
+	s, err = godeps.GetDeps("./internal/... std=false")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("mermaid", ""+
+			"graph LR\n"+
+			"    n0[\"internal/one\"]\n"+
+			"    n1[\"internal/two\"]\n"+
+			"    n0 --> n1\n",
+		),
+	)
+
+	chk.Stdout(
+		"Loading package imports for: ./internal/...",
+		"Loading package imports for: ./internal/...",
+	)
+}
+
+func Test_GetDeps_Errors(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	_, err := godeps.GetDeps("./... depth=-1")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"depth=\"-1\"",
+		),
+	)
+
+	_, err = godeps.GetDeps("./... format=svg")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"format=\"svg\"",
+		),
+	)
+
+	_, err = godeps.GetDeps("./... internal=maybe")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidOption,
+			"internal=\"maybe\"",
+		),
+	)
+
+	_, err = godeps.GetDeps("./pkgName")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidPackage,
+			"\"pkgName\"",
+		),
+	)
+
+	_, err = godeps.GetDeps("./DOES_NOT_EXIST/...")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidDirectory,
+			"\"./DOES_NOT_EXIST\"",
+		),
+	)
+
+	chk.Stdout()
+}
@@ -0,0 +1,9 @@
+package app
+
+import (
+	"fmt"
+
+	"example.com/app/internal/one"
+)
+
+func Run() { fmt.Println(one.One()) }
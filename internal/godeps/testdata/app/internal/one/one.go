@@ -0,0 +1,9 @@
+package one
+
+import (
+	"strings"
+
+	"example.com/app/internal/two"
+)
+
+func One() string { return strings.ToUpper(two.Two()) }
@@ -0,0 +1,5 @@
+package two
+
+import "os"
+
+func Two() string { return os.Args[0] }
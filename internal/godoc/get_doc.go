@@ -26,6 +26,41 @@ import (
 	"github.com/dancsecs/gotomd/internal/gopkg"
 )
 
+// parseDocCmdOptions extracts any build options from the command (defaulting
+// to those supplied on the command line) along with any additional options
+// before parsing the package objects.
+func parseDocCmdOptions(
+	cmd string, extra ...string,
+) ([]string, []string, gopkg.Build, cmds.Options, error) {
+	var (
+		dirs    []string
+		actions []string
+		opts    cmds.Options
+		build   gopkg.Build
+		err     error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(cmd, gopkg.BuildOptions(extra...)...)
+	if err == nil {
+		build = gopkg.BuildFromOptions(opts)
+		dirs, actions, err = cmds.ParseCmds(cmd)
+	}
+
+	if err == nil {
+		return dirs, actions, build, opts, nil
+	}
+
+	return nil, nil, build, nil, err //nolint:wrapcheck // Ok.
+}
+
+// parseDocCmd extracts any build options from the command (defaulting to
+// those supplied on the command line) before parsing the package objects.
+func parseDocCmd(cmd string) ([]string, []string, gopkg.Build, error) {
+	dirs, actions, build, _, err := parseDocCmdOptions(cmd)
+
+	return dirs, actions, build, err
+}
+
 // GetDoc returns the go documentation requested.
 func GetDoc(cmd string) (string, error) {
 	var (
@@ -33,14 +68,19 @@ func GetDoc(cmd string) (string, error) {
 		res   string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	dir, action, build, err := parseDocCmd(cmd)
 	for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-		dInfo, err = gopkg.Info(dir[i], action[i])
+		dInfo, err = gopkg.InfoFor(dir[i], action[i], build)
 		if err == nil {
 			if res != "" {
 				res += "\n\n"
 			}
 
+			if mark := format.Deprecated(action[i]); mark != "" &&
+				dInfo.Deprecation() != "" {
+				res += mark + "\n\n"
+			}
+
 			res += format.Inline("go", dInfo.Declaration()) + "\n\n" +
 				dInfo.Comment()
 		}
@@ -61,10 +101,10 @@ func GetDocDecl(cmd string) (string, error) {
 		res   string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	dir, action, build, err := parseDocCmd(cmd)
 	if err == nil {
 		for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-			dInfo, err = gopkg.Info(dir[i], action[i])
+			dInfo, err = gopkg.InfoFor(dir[i], action[i], build)
 			if err == nil {
 				if res != "" {
 					res += "\n"
@@ -90,10 +130,10 @@ func GetDocDeclSingle(cmd string) (string, error) {
 		res   string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	dir, action, build, err := parseDocCmd(cmd)
 	if err == nil {
 		for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-			dInfo, err = gopkg.Info(dir[i], action[i])
+			dInfo, err = gopkg.InfoFor(dir[i], action[i], build)
 			if err == nil {
 				if res != "" {
 					res += "\n"
@@ -119,10 +159,10 @@ func GetDocDeclNatural(cmd string) (string, error) {
 		res   string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	dir, action, build, err := parseDocCmd(cmd)
 	if err == nil {
 		for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-			dInfo, err = gopkg.Info(dir[i], action[i])
+			dInfo, err = gopkg.InfoFor(dir[i], action[i], build)
 			if err == nil {
 				if res != "" {
 					res += "\n\n"
@@ -149,10 +189,10 @@ func GetDocDeclConstantBlock(cmd string) (string, error) {
 		res   string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	dir, action, build, err := parseDocCmd(cmd)
 	if err == nil {
 		for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-			dInfo, err = gopkg.Info(dir[i], action[i])
+			dInfo, err = gopkg.InfoFor(dir[i], action[i], build)
 			if err == nil {
 				if res != "" {
 					res += "\n\n"
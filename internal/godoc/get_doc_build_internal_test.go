@@ -0,0 +1,111 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godoc
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GetDoc_BuildOptions(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+	format.ForMarkdown()
+
+	dir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(dir, "go.mod", []byte("module tagged\n"))
+	_ = chk.CreateTmpFileAs(dir, "default.go", []byte(""+
+		"//go:build !integration\n\n"+
+		"package tagged\n\n"+
+		"// Variant returns the default variant.\n"+
+		"func Variant() string { return \"default\" }\n",
+	))
+	_ = chk.CreateTmpFileAs(dir, "integration.go", []byte(""+
+		"//go:build integration\n\n"+
+		"package tagged\n\n"+
+		"// Variant returns the integration variant.\n"+
+		"func Variant() string { return \"integration\" }\n",
+	))
+	_ = chk.CreateTmpFileAs(dir, "other.go", []byte(""+
+		"//go:build !windows\n\n"+
+		"package tagged\n\n"+
+		"// Sep is the platform separator.\n"+
+		"const Sep = \"/\"\n",
+	))
+	_ = chk.CreateTmpFileAs(dir, "sep_windows.go", []byte(""+
+		"package tagged\n\n"+
+		"// Sep is the windows separator.\n"+
+		"const Sep = `\\`\n",
+	))
+
+	t.Chdir(dir)
+
+	s, err := GetDocDeclNatural("./Variant")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("go", ""+
+			"// Variant returns the default variant.\n"+
+			"func Variant() string",
+		),
+	)
+
+	s, err = GetDocDeclNatural("./Variant tags=integration")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("go", ""+
+			"// Variant returns the integration variant.\n"+
+			"func Variant() string",
+		),
+	)
+
+	s, err = GetDocDeclSingle("./Sep goos=windows goarch=amd64 Variant")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("go", "const Sep = `\\`\nfunc Variant() string"),
+	)
+
+	s, err = GetDoc("./Sep goos=windows goos=linux")
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrDuplicateOption,
+			"\"goos\"",
+		),
+	)
+	chk.Str(s, "")
+
+	chk.Stdout(
+		"Loading package info for: .",
+		"getInfo(\"Variant\")",
+		"Loading package info for: . (tags=integration)",
+		"getInfo(\"Variant\")",
+		"Loading package info for: . (goos=windows goarch=amd64)",
+		"getInfo(\"Sep\")",
+		"getInfo(\"Variant\")",
+	)
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
 	"github.com/dancsecs/sztestlog"
 )
 
@@ -406,3 +407,46 @@ func Test_GetDoc_GetDocConstantBlockTwo(t *testing.T) {
 		"getInfo(\"ConstantGroupA\")",
 	)
 }
+
+func Test_GetDoc_GetDoc_Deprecated(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+	format.ForMarkdown()
+
+	dir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(dir, "go.mod", []byte("module deprecated\n"))
+	_ = chk.CreateTmpFileAs(dir, "deprecated.go", []byte(""+
+		"package deprecated\n\n"+
+		"// New is current.\n"+
+		"func New() {}\n\n"+
+		"// Old is old.\n"+
+		"//\n"+
+		"// Deprecated: Use [New] instead.\n"+
+		"func Old() {}\n",
+	))
+
+	t.Chdir(dir)
+
+	s, err := GetDoc("./Old New")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		""+
+			"~~`Old`~~ **DEPRECATED**\n\n"+
+			format.Inline("go", "func Old()")+"\n\n"+
+			"Old is old.\n"+
+			"\n"+
+			"Deprecated: Use [New] instead.\n"+
+			"\n"+
+			format.Inline("go", "func New()")+"\n\n"+
+			"New is current.",
+	)
+
+	chk.Stdout(
+		"Loading package info for: .",
+		"getInfo(\"Old\")",
+		"getInfo(\"New\")",
+	)
+}
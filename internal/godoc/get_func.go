@@ -0,0 +1,72 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godoc
+
+import (
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+)
+
+// Options controlling the function source.
+const (
+	optDoc   = "doc"
+	optElide = "elide"
+)
+
+// GetFunc returns the complete source of the requested functions or methods
+// ("Type.Method") optionally preceded by their doc comments and with the
+// bodies of nested function literals elided.
+func GetFunc(cmd string) (string, error) {
+	var (
+		dInfo   *gopkg.DocInfo
+		withDoc bool
+		elide   bool
+		res     []string
+	)
+
+	dir, action, build, opts, err := parseDocCmdOptions(cmd, optDoc, optElide)
+
+	if err == nil {
+		withDoc, err = opts.Bool(optDoc, false)
+	}
+
+	if err == nil {
+		elide, err = opts.Bool(optElide, false)
+	}
+
+	for i, mi := 0, len(dir); i < mi && err == nil; i++ {
+		dInfo, err = gopkg.FuncInfo(dir[i], action[i], build, elide)
+		if err == nil {
+			src := strings.Join(dInfo.Body(), "\n")
+			if withDoc && dInfo.Comment() != "" {
+				src = dInfo.NaturalComment() + "\n" + src
+			}
+
+			res = append(res, src)
+		}
+	}
+
+	if err == nil {
+		return format.Inline("go", strings.Join(res, "\n\n")), nil
+	}
+
+	return "", err //nolint:wrapcheck // Ok.
+}
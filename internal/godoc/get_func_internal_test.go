@@ -0,0 +1,128 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godoc
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GetFunc(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+	format.ForMarkdown()
+
+	dir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(dir, "go.mod", []byte("module funcs\n"))
+	_ = chk.CreateTmpFileAs(dir, "funcs.go", []byte(""+
+		"package funcs\n\n"+
+		"// Counter counts.\n"+
+		"type Counter struct{ n int }\n\n"+
+		"// Inc increments the counter.\n"+
+		"func (c *Counter) Inc() {\n"+
+		"\tc.n++\n"+
+		"}\n\n"+
+		"func Apply(values []int) []int {\n"+
+		"\tdouble := func(v int) int {\n"+
+		"\t\tinner := func() int { return v }\n\n"+
+		"\t\treturn inner() * 2\n"+
+		"\t}\n\n"+
+		"\tfor i, v := range values {\n"+
+		"\t\tvalues[i] = double(v)\n"+
+		"\t}\n\n"+
+		"\tdefer func() {}()\n\n"+
+		"\treturn values\n"+
+		"}\n",
+	))
+
+	t.Chdir(dir)
+
+	s, err := GetFunc("./Counter.Inc doc=true")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("go", ""+
+			"// Inc increments the counter.\n"+
+			"func (c *Counter) Inc() {\n"+
+			"    c.n++\n"+
+			"}",
+		),
+	)
+
+	s, err = GetFunc("./Apply doc=true elide=true Counter.Inc")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("go", ""+
+			"func Apply(values []int) []int {\n"+
+			"    double := func(v int) int { ... }\n\n"+
+			"    for i, v := range values {\n"+
+			"        values[i] = double(v)\n"+
+			"    }\n\n"+
+			"    defer func() { ... }()\n\n"+
+			"    return values\n"+
+			"}\n\n"+
+			"// Inc increments the counter.\n"+
+			"func (c *Counter) Inc() {\n"+
+			"    c.n++\n"+
+			"}",
+		),
+	)
+
+	s, err = GetFunc("./Apply")
+	chk.NoErr(err)
+	chk.Str(
+		s,
+		format.Inline("go", ""+
+			"func Apply(values []int) []int {\n"+
+			"    double := func(v int) int {\n"+
+			"        inner := func() int { return v }\n\n"+
+			"        return inner() * 2\n"+
+			"    }\n\n"+
+			"    for i, v := range values {\n"+
+			"        values[i] = double(v)\n"+
+			"    }\n\n"+
+			"    defer func() {}()\n\n"+
+			"    return values\n"+
+			"}",
+		),
+	)
+
+	_, err = GetFunc("./Counter")
+	chk.Err(err, chk.ErrChain(errs.ErrUnknownFunction, "Counter"))
+
+	_, err = GetFunc("./Missing")
+	chk.Err(err, chk.ErrChain(errs.ErrUnknownFunction, "Missing"))
+
+	_, err = GetFunc("./Counter.Dec")
+	chk.Err(err, chk.ErrChain(errs.ErrUnknownFunction, "Counter.Dec"))
+
+	_, err = GetFunc("./Apply elide=maybe")
+	chk.Err(err, chk.ErrChain(errs.ErrInvalidOption, `elide="maybe"`))
+
+	chk.Stdout(
+		"Loading package info for: .",
+	)
+}
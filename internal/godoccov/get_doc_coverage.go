@@ -0,0 +1,219 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godoccov
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/szlog"
+)
+
+// Options controlling the report.
+const (
+	optMin     = "min"
+	optDetails = "details"
+)
+
+const maxPercent = 100
+
+type report struct {
+	dirs       []string
+	coverage   []*gopkg.DocCoverage
+	total      int
+	documented int
+}
+
+func (r *report) percent() float64 {
+	if r.total == 0 {
+		return maxPercent
+	}
+
+	return float64(r.documented) * maxPercent / float64(r.total)
+}
+
+func percentStr(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
+
+func (r *report) table() string {
+	var res strings.Builder
+
+	res.WriteString("| package | documented | total | coverage |\n")
+	res.WriteString("| ------- | ---------: | ----: | -------: |\n")
+
+	for i, cov := range r.coverage {
+		res.WriteString(fmt.Sprintf("| `%s` | %d | %d | %s |\n",
+			r.dirs[i], cov.Documented, cov.Total, percentStr(cov.Percent()),
+		))
+	}
+
+	res.WriteString(fmt.Sprintf("| **total** | %d | %d | %s |\n",
+		r.documented, r.total, percentStr(r.percent()),
+	))
+
+	return res.String()
+}
+
+func (r *report) issues() []string {
+	var lines []string
+
+	for i, cov := range r.coverage {
+		for _, issue := range cov.Issues {
+			lines = append(lines, r.dirs[i]+": "+issue.String())
+		}
+	}
+
+	return lines
+}
+
+func parseCoverageCmd(
+	cmd string,
+) ([]string, []string, int, bool, gopkg.Build, error) {
+	var (
+		dirs    []string
+		actions []string
+		opts    cmds.Options
+		build   gopkg.Build
+		minimum int
+		details bool
+		err     error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(
+		cmd, gopkg.BuildOptions(optMin, optDetails)...,
+	)
+
+	if err == nil {
+		build = gopkg.BuildFromOptions(opts)
+		minimum, err = opts.Int(optMin, 0)
+	}
+
+	if err == nil && (minimum < 0 || minimum > maxPercent) {
+		err = fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optMin, opts[optMin],
+		)
+	}
+
+	if err == nil {
+		details, err = opts.Bool(optDetails, true)
+	}
+
+	if err == nil {
+		dirs, actions, err = cmds.ParseCmds(cmd)
+	}
+
+	for i, mi := 0, len(actions); i < mi && err == nil; i++ {
+		if actions[i] != "." && actions[i] != "..." {
+			err = fmt.Errorf("%w: %q", errs.ErrInvalidPackage, actions[i])
+		}
+	}
+
+	if err == nil {
+		return dirs, actions, minimum, details, build, nil
+	}
+
+	return nil, nil, 0, false, build, err
+}
+
+func buildReport(
+	dirs, actions []string, build gopkg.Build,
+) (*report, error) {
+	var (
+		rpt     = new(report)
+		pkgDirs []string
+		cov     *gopkg.DocCoverage
+		err     error
+	)
+
+	for i, mi := 0, len(dirs); i < mi && err == nil; i++ {
+		pkgDirs, err = gopkg.PackageDirs(dirs[i], actions[i], build)
+
+		for j, mj := 0, len(pkgDirs); j < mj && err == nil; j++ {
+			cov, err = gopkg.DocCoverageFor(pkgDirs[j], build)
+			if err == nil {
+				rpt.dirs = append(rpt.dirs, pkgDirs[j])
+				rpt.coverage = append(rpt.coverage, cov)
+				rpt.total += cov.Total
+				rpt.documented += cov.Documented
+			}
+		}
+	}
+
+	if err == nil {
+		return rpt, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// GetDocCoverage reports the documentation coverage of the packages in the
+// relative directories ("./dir/." for a single package or "./dir/..."
+// recursively) as a table followed by a list of each exported identifier
+// missing a conventional doc comment.  An error is returned if the overall
+// coverage falls below the requested minimum percentage.
+func GetDocCoverage(cmd string) (string, error) {
+	var (
+		dirs    []string
+		actions []string
+		minimum int
+		details bool
+		build   gopkg.Build
+		rpt     *report
+		res     string
+		err     error
+	)
+
+	dirs, actions, minimum, details, build, err = parseCoverageCmd(cmd)
+
+	if err == nil {
+		rpt, err = buildReport(dirs, actions, build)
+	}
+
+	if err == nil && rpt.percent() < float64(minimum) {
+		for _, issue := range rpt.issues() {
+			szlog.Say0(issue, "\n")
+		}
+
+		err = fmt.Errorf("%w: %s < %d%%",
+			errs.ErrDocCoverage, percentStr(rpt.percent()), minimum,
+		)
+	}
+
+	if err == nil {
+		if format.IsForMarkdown() {
+			res = strings.TrimRight(rpt.table(), "\n")
+		} else {
+			res = format.Inline("text", rpt.table())
+		}
+
+		if issues := rpt.issues(); details && len(issues) > 0 {
+			res += "\n\n" + format.Inline("text", strings.Join(issues, "\n"))
+		}
+
+		return res, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
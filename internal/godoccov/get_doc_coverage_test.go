@@ -0,0 +1,120 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package godoccov_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/godoccov"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupModule(t *testing.T, chk *sztest.Chk) {
+	t.Helper()
+
+	_ = testmod.Setup(t, chk, "app")
+	testmod.Args(chk)
+
+	gopkg.Reset()
+}
+
+func Test_GetDocCoverage_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	_, err := godoccov.GetDocCoverage("./sub/. min=101")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: min="101"`)
+
+	_, err = godoccov.GetDocCoverage("./sub/. details=maybe")
+	chk.Err(err, chk.ErrChain(errs.ErrInvalidOption, `details="maybe"`))
+
+	_, err = godoccov.GetDocCoverage("./sub/Sub")
+	chk.Err(err, errs.ErrInvalidPackage.Error()+`: "Sub"`)
+
+	chk.Stdout()
+}
+
+func Test_GetDocCoverage(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	res, err := godoccov.GetDocCoverage("./... min=50")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| package | documented | total | coverage |\n"+
+		"| ------- | ---------: | ----: | -------: |\n"+
+		"| `.` | 2 | 2 | 100.0% |\n"+
+		"| `./sub` | 1 | 3 | 33.3% |\n"+
+		"| **total** | 3 | 5 | 60.0% |\n"+
+		"\n"+
+		"```text\n"+
+		"./sub: func Sub: doc comment should start with \"Sub\"\n"+
+		"./sub: type Missing: missing doc comment\n"+
+		"```",
+	)
+
+	res, err = godoccov.GetDocCoverage("./. details=false")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| package | documented | total | coverage |\n"+
+		"| ------- | ---------: | ----: | -------: |\n"+
+		"| `.` | 2 | 2 | 100.0% |\n"+
+		"| **total** | 2 | 2 | 100.0% |",
+	)
+
+	_, err = godoccov.GetDocCoverage("./... min=61")
+	chk.Err(err, errs.ErrDocCoverage.Error()+": 60.0% < 61%")
+
+	chk.Stdout(
+		"./sub: func Sub: doc comment should start with \"Sub\"",
+		"./sub: type Missing: missing doc comment",
+	)
+}
+
+func Test_GetDocCoverage_Tags(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	setupModule(t, chk)
+
+	res, err := godoccov.GetDocCoverage("./... tags=integration")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| package | documented | total | coverage |\n"+
+		"| ------- | ---------: | ----: | -------: |\n"+
+		"| `.` | 2 | 2 | 100.0% |\n"+
+		"| `./sub` | 1 | 3 | 33.3% |\n"+
+		"| `./tagged` | 1 | 2 | 50.0% |\n"+
+		"| **total** | 4 | 7 | 57.1% |\n"+
+		"\n"+
+		"```text\n"+
+		"./sub: func Sub: doc comment should start with \"Sub\"\n"+
+		"./sub: type Missing: missing doc comment\n"+
+		"./tagged: func Hidden: missing doc comment\n"+
+		"```",
+	)
+}
@@ -0,0 +1,5 @@
+// Package app is documented.
+package app
+
+// Run is documented.
+func Run() {}
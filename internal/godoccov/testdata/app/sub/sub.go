@@ -0,0 +1,7 @@
+// Package sub is documented.
+package sub
+
+// Does not start with the name.
+func Sub() {}
+
+type Missing int
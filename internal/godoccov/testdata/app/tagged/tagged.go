@@ -0,0 +1,6 @@
+//go:build integration
+
+// Package tagged is only built with the integration tag.
+package tagged
+
+func Hidden() {}
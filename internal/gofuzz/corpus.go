@@ -0,0 +1,187 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gofuzz
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// First line of every file in a fuzz corpus.
+const corpusHeader = "go test fuzz v1"
+
+// Types a corpus value (or seed) may be converted to.
+//
+//nolint:goCheckNoGlobals // Ok.
+var conversions = []string{
+	"string", "bool", "byte", "rune",
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+	"float32", "float64",
+}
+
+func isConversion(fun ast.Expr) bool {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return slices.Contains(conversions, f.Name)
+	case *ast.ArrayType:
+		return f.Len == nil // []byte
+	default:
+		return false
+	}
+}
+
+// valueText renders a value readably unwrapping any conversion and quoting
+// strings in their canonical Go form.  Other expressions are rendered from
+// their source (on a single line).
+func valueText(expr ast.Expr, source func(ast.Node) string) string {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			value, err := strconv.Unquote(e.Value)
+			if err == nil {
+				return strconv.Quote(value)
+			}
+		}
+
+		return e.Value
+	case *ast.CallExpr:
+		if len(e.Args) == 1 && isConversion(e.Fun) {
+			return valueText(e.Args[0], source)
+		}
+	case *ast.ParenExpr:
+		return valueText(e.X, source)
+	}
+
+	return strings.Join(strings.Fields(source(expr)), " ")
+}
+
+// entry holds the values of a single seed or corpus file.
+type entry struct {
+	name   string
+	values []string
+	isNew  bool
+}
+
+// decodeCorpus decodes the values of a corpus file.  Each value is written
+// on its own line as a Go conversion ("string(\"abc\")", "int(5)").
+func decodeCorpus(name, data string) (*entry, error) {
+	var (
+		lines = strings.Split(strings.TrimRight(data, "\n"), "\n")
+		e     = &entry{name: name, values: nil, isNew: false}
+		expr  ast.Expr
+		err   error
+	)
+
+	if lines[0] != corpusHeader {
+		err = fmt.Errorf("%w: %s: missing %q",
+			errs.ErrInvalidCorpus, name, corpusHeader,
+		)
+	}
+
+	for i, mi := 1, len(lines); i < mi && err == nil; i++ {
+		line := lines[i]
+
+		// Positions are offsets into the line starting at one.
+		source := func(n ast.Node) string {
+			return line[n.Pos()-1 : n.End()-1]
+		}
+
+		expr, err = parser.ParseExpr(line)
+		if err == nil {
+			e.values = append(e.values, valueText(expr, source))
+		} else {
+			err = fmt.Errorf("%w: %s: line %d: %q",
+				errs.ErrInvalidCorpus, name, i+1, line,
+			)
+		}
+	}
+
+	if err == nil {
+		return e, nil
+	}
+
+	return nil, err
+}
+
+// corpusDir returns the directory holding the corpus of the fuzz target.
+func corpusDir(dir, name string) string {
+	return filepath.Join(dir, "testdata", "fuzz", name)
+}
+
+// corpusFiles returns the names of the target's corpus files in order.
+func corpusFiles(dir, name string) ([]string, error) {
+	files, err := os.ReadDir(corpusDir(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	var names []string
+
+	for _, f := range files {
+		if !f.IsDir() {
+			names = append(names, f.Name())
+		}
+	}
+
+	return names, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// readCorpus decodes each of the target's corpus files.
+func readCorpus(dir, name string) ([]*entry, error) {
+	var (
+		names   []string
+		data    []byte
+		e       *entry
+		entries []*entry
+		err     error
+	)
+
+	names, err = corpusFiles(dir, name)
+
+	for i, mi := 0, len(names); i < mi && err == nil; i++ {
+		data, err = os.ReadFile( //nolint:gosec // Ok.
+			filepath.Join(corpusDir(dir, name), names[i]),
+		)
+
+		if err == nil {
+			e, err = decodeCorpus(names[i], string(data))
+		}
+
+		if err == nil {
+			entries = append(entries, e)
+		}
+	}
+
+	if err == nil {
+		return entries, nil
+	}
+
+	return nil, err
+}
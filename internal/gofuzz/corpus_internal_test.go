@@ -0,0 +1,58 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gofuzz
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Corpus_Decode(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	e, err := decodeCorpus("abc", ""+
+		corpusHeader+"\n"+
+		"[]byte(\"\\x00\\xff\")\n"+
+		"string(`raw\"quote`)\n"+
+		"rune('x')\n"+
+		"byte('\\n')\n"+
+		"bool(true)\n"+
+		"float64(-1.5)\n"+
+		"float64(math.Float64frombits(0x7ff8000000000001))\n",
+	)
+	chk.NoErr(err)
+	chk.Str(e.name, "abc")
+	chk.StrSlice(e.values, []string{
+		`"\x00\xff"`,
+		`"raw\"quote"`,
+		`'x'`,
+		`'\n'`,
+		`true`,
+		`-1.5`,
+		`math.Float64frombits(0x7ff8000000000001)`,
+	})
+
+	_, err = decodeCorpus("def", "string(\"x\")\n")
+	chk.Err(err, ""+
+		errs.ErrInvalidCorpus.Error()+`: def: missing "go test fuzz v1"`,
+	)
+}
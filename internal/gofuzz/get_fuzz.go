@@ -0,0 +1,304 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gofuzz
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/gotomd/internal/update"
+)
+
+// Options controlling the fuzz run.
+const (
+	optFuzztime = "fuzztime"
+	optTimeout  = "timeout"
+)
+
+const fuzzPrefix = "Fuzz"
+
+// "1000x" iterations.
+var fuzzIterations = regexp.MustCompile(`^[1-9]\d*x$`)
+
+// Line reporting the input Go recorded in the corpus for a new failure.
+// "Failing input written to testdata/fuzz/FuzzParse/582528ddfad69eb5".
+var fuzzRecorded = regexp.MustCompile(`(?m)^\s*Failing input written to `)
+
+// Progress and summary lines dropped from the failure report.
+// "fuzz: elapsed: 0s, gathering baseline coverage: 0/3 completed".
+var fuzzNoise = regexp.MustCompile(`^(?:fuzz: |FAIL\b|ok\s|exit status )`)
+
+type fuzzOptions struct {
+	fuzztime string
+	timeout  time.Duration
+}
+
+func parseFuzztime(value string) error {
+	if fuzzIterations.MatchString(value) {
+		return nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fmt.Errorf(
+			"%w: %s=%q", errs.ErrInvalidOption, optFuzztime, value,
+		)
+	}
+
+	return nil
+}
+
+func parseFuzzCmd(cmd string) (string, string, *fuzzOptions, error) {
+	var (
+		dir     string
+		name    string
+		opts    cmds.Options
+		fuzzOpt = new(fuzzOptions)
+		err     error
+	)
+
+	cmd, opts, err = cmds.ExtractOptions(cmd, optFuzztime, optTimeout)
+
+	if err == nil {
+		fuzzOpt.fuzztime = opts.Value(optFuzztime, "")
+		if opts.Has(optFuzztime) {
+			err = parseFuzztime(fuzzOpt.fuzztime)
+		}
+	}
+
+	if err == nil {
+		fuzzOpt.timeout, err = opts.Duration(optTimeout, proc.Timeout())
+	}
+
+	if err == nil {
+		dir, name, err = cmds.ParseCmd(strings.TrimSpace(cmd))
+	}
+
+	if err == nil && !strings.HasPrefix(name, fuzzPrefix) {
+		err = fmt.Errorf("%w: %q", errs.ErrUnknownFunction, name)
+	}
+
+	if err == nil {
+		return dir, name, fuzzOpt, nil
+	}
+
+	return "", "", nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// failureReport returns the normalized output of a failing fuzz run
+// without its progress and summary lines.
+func failureReport(out string) (string, error) {
+	var kept []string
+
+	for _, line := range strings.Split(out, "\n") {
+		if !fuzzNoise.MatchString(line) {
+			kept = append(kept, strings.TrimRight(line, " \t"))
+		}
+	}
+
+	return normalize.String( //nolint:wrapcheck // Caller will wrap error.
+		strings.Trim(strings.Join(kept, "\n"), "\n"),
+	)
+}
+
+// fuzzFailed reports the normalized output of a fuzz run that failed
+// without recording a failing input (such as a build failure or a failing
+// seed).
+func fuzzFailed(name, relDir, out string) error {
+	out, err := failureReport(out)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: go test -fuzz %s %s\n%s",
+		errs.ErrTestsFailed, name, relDir, out,
+	)
+}
+
+// runFuzz fuzzes the target for the requested time returning a report of
+// the new failure found.  Go records the failing input in the target's
+// corpus.  Any other failure is returned as an error.
+func runFuzz(dir, name string, opts *fuzzOptions) (string, error) {
+	var (
+		rawRes []byte
+		runDir string
+		relDir string
+		wsEnv  []string
+		res    string
+	)
+
+	runDir, relDir, wsEnv, err := gowork.Target(dir)
+
+	if err == nil {
+		c := proc.Command(opts.timeout, "go", "test",
+			"-run", "^$", "-fuzz", "^"+name+"$", "-fuzztime", opts.fuzztime,
+			relDir,
+		)
+		c.Dir = runDir
+		c.Env = append(os.Environ(), wsEnv...)
+
+		rawRes, err = c.CombinedOutput()
+
+		switch {
+		case err == nil || proc.Stopped(err):
+		case fuzzRecorded.Match(rawRes):
+			res, err = failureReport(string(rawRes))
+		default:
+			err = fuzzFailed(name, relDir, string(rawRes))
+		}
+	}
+
+	if err == nil {
+		return res, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// cell renders a value as code escaping characters that would otherwise
+// end the table cell or the code span.
+func cell(value string) string {
+	value = strings.ReplaceAll(value, "|", `\|`)
+	if strings.Contains(value, "`") {
+		return "`` " + value + " ``"
+	}
+
+	return "`" + value + "`"
+}
+
+func table(params []string, entries []*entry) string {
+	columns := len(params)
+	for _, e := range entries {
+		columns = max(columns, len(e.values))
+	}
+
+	var res strings.Builder
+
+	res.WriteString("| Entry |")
+
+	for i := range columns {
+		if i < len(params) {
+			res.WriteString(" " + params[i] + " |")
+		} else {
+			res.WriteString(fmt.Sprintf(" value %d |", i+1))
+		}
+	}
+
+	res.WriteString("\n| ----- |" + strings.Repeat(" ----- |", columns) + "\n")
+
+	for _, e := range entries {
+		label := e.name
+		if e.isNew {
+			label += " (new failure)"
+		}
+
+		res.WriteString("| " + label + " |")
+
+		for i := range columns {
+			if i < len(e.values) {
+				res.WriteString(" " + cell(e.values[i]) + " |")
+			} else {
+				res.WriteString(" |")
+			}
+		}
+
+		res.WriteString("\n")
+	}
+
+	return res.String()
+}
+
+// GetFuzz documents the fuzz target in the package directory
+// ("./dir/FuzzParse") as a table of its seed corpus (added with f.Add)
+// followed by each file recorded under testdata/fuzz/FuzzParse with its
+// values decoded.  If a fuzztime is given the target is first fuzzed for
+// that long and any failure found is reported after the table with the
+// failing input marked as new.  Fuzzed results are bracketed so up to date
+// checks ignore them and are not run when only checking.
+func GetFuzz(cmd string) (string, error) {
+	var (
+		dir      string
+		name     string
+		opts     *fuzzOptions
+		tgt      *target
+		previous []string
+		failure  string
+		corpus   []*entry
+		res      string
+		err      error
+	)
+
+	dir, name, opts, err = parseFuzzCmd(cmd)
+
+	if err == nil && opts.fuzztime != "" && args.CheckUpToDate() {
+		return update.Volatile(""), nil
+	}
+
+	if err == nil {
+		tgt, err = findTarget(dir, name)
+	}
+
+	if err == nil && opts.fuzztime != "" {
+		previous, err = corpusFiles(dir, name)
+		if err == nil {
+			failure, err = runFuzz(dir, name, opts)
+		}
+	}
+
+	if err == nil {
+		corpus, err = readCorpus(dir, name)
+	}
+
+	if err == nil {
+		for _, e := range corpus {
+			e.isNew = opts.fuzztime != "" && !slices.Contains(previous, e.name)
+		}
+
+		res = table(tgt.params, append(tgt.seeds, corpus...))
+
+		if format.IsForMarkdown() {
+			res = strings.TrimRight(res, "\n")
+		} else {
+			res = format.Inline("text", res)
+		}
+
+		if failure != "" {
+			res += "\n\n" + format.Inline("text", failure)
+		}
+
+		if opts.fuzztime != "" {
+			res = update.Volatile(res)
+		}
+
+		return res, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
@@ -0,0 +1,149 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gofuzz_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gofuzz"
+	"github.com/dancsecs/gotomd/internal/testmod"
+	"github.com/dancsecs/gotomd/internal/update"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GetFuzz_InvalidCmd(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	_, err := gofuzz.GetFuzz("./TestParse")
+	chk.Err(err, errs.ErrUnknownFunction.Error()+`: "TestParse"`)
+
+	_, err = gofuzz.GetFuzz("./FuzzMissing")
+	chk.Err(err, errs.ErrUnknownFunction.Error()+`: "FuzzMissing"`)
+
+	_, err = gofuzz.GetFuzz("./FuzzParse fuzztime=soon")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: fuzztime="soon"`)
+
+	chk.NoErr(os.WriteFile(
+		filepath.Join("testdata", "fuzz", "FuzzParse", "bad"),
+		[]byte("go test fuzz v1\nstring(\n"), 0o600,
+	))
+
+	_, err = gofuzz.GetFuzz("./FuzzParse")
+	chk.Err(err,
+		errs.ErrInvalidCorpus.Error()+`: bad: line 2: "string("`,
+	)
+}
+
+func Test_GetFuzz(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	res, err := gofuzz.GetFuzz("./FuzzParse")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"| Entry | input | n |\n"+
+		"| ----- | ----- | ----- |\n"+
+		"| seed#0 | `\"a\\|b\"` | `1` |\n"+
+		"| seed#1 | `\"raw\"` | `-2` |\n"+
+		"| 0123abcd | `\"tab\\there\"` | `-5` |",
+	)
+}
+
+func Test_GetFuzz_Failures(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	res, err := gofuzz.GetFuzz("./FuzzEmpty fuzztime=30s")
+	chk.NoErr(err)
+	chk.True(strings.HasPrefix(res, ""+
+		"<!--- "+update.VolatileBegin+" -->\n"+
+		"| Entry | s |\n"+
+		"| ----- | ----- |\n"+
+		"| seed#0 | `\"\"` |\n",
+	))
+	chk.True(strings.Contains(res, " (new failure) | "))
+	chk.True(strings.Contains(res, ""+
+		"        app_test.go:24: not empty\n"+
+		"\n"+
+		"    Failing input written to testdata/fuzz/FuzzEmpty/",
+	))
+	chk.True(strings.HasSuffix(res, "<!--- "+update.VolatileEnd+" -->"))
+}
+
+func Test_GetFuzz_RunFailed(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+
+	_, err := gofuzz.GetFuzz("./FuzzSeed fuzztime=1x")
+	chk.Err(err, ""+
+		errs.ErrTestsFailed.Error()+": go test -fuzz FuzzSeed .\n"+
+		"failure while testing seed corpus entry: FuzzSeed/seed#0\n"+
+		"--- FAIL: FuzzSeed (0.0s)\n"+
+		"    --- FAIL: FuzzSeed (0.0s)\n"+
+		"        app_test.go:15: boom",
+	)
+
+	chk.NoErr(os.WriteFile("broken_test.go", []byte(""+
+		"package app\n\n"+
+		"func broken() int { return \"x\" }\n",
+	), 0o600))
+
+	_, err = gofuzz.GetFuzz("./FuzzParse fuzztime=1x")
+	chk.Err(err, ""+
+		errs.ErrTestsFailed.Error()+": go test -fuzz FuzzParse .\n"+
+		"# example.com/app [example.com/app.test]\n"+
+		"./broken_test.go:3:28: cannot use \"x\" (untyped string constant) "+
+		"as int value in return statement",
+	)
+}
+
+func Test_GetFuzz_UpToDate(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	testmod.Setup(t, chk, "app")
+	testmod.Args(chk, "-u")
+
+	update.ResetUpToDate()
+
+	res, err := gofuzz.GetFuzz("./FuzzEmpty fuzztime=30s")
+	chk.NoErr(err)
+	chk.Str(res, ""+
+		"<!--- "+update.VolatileBegin+" -->\n"+
+		"\n"+
+		"<!--- "+update.VolatileEnd+" -->",
+	)
+
+	entries, err := os.ReadDir(filepath.Join("testdata", "fuzz", "FuzzEmpty"))
+	chk.True(os.IsNotExist(err))
+	chk.Int(len(entries), 0)
+}
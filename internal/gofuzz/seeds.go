@@ -0,0 +1,166 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gofuzz
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+// target holds the parameter names and seed corpus (the values added with
+// f.Add) of a fuzz target.
+type target struct {
+	params []string
+	seeds  []*entry
+}
+
+// fuzzParam returns the name of the *testing.F parameter of the function
+// if it looks like a fuzz target.
+func fuzzParam(fn *ast.FuncDecl) (string, bool) {
+	params := fn.Type.Params.List
+	if fn.Recv != nil || len(params) != 1 || len(params[0].Names) != 1 {
+		return "", false
+	}
+
+	return params[0].Names[0].Name, true
+}
+
+// funcLitParams returns the names of the fuzz function's parameters after
+// the leading *testing.T.
+func funcLitParams(lit *ast.FuncLit) []string {
+	var names []string
+
+	for _, field := range lit.Type.Params.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+
+		if len(field.Names) == 0 {
+			names = append(names, "_")
+		}
+	}
+
+	if len(names) > 0 {
+		names = names[1:]
+	}
+
+	return names
+}
+
+// inspect collects the seeds added to the fuzz target and the names of the
+// fuzzed parameters.  Positions are offsets into the source starting at
+// one.
+func (t *target) inspect(fn *ast.FuncDecl, src []byte) {
+	source := func(n ast.Node) string {
+		return string(src[n.Pos()-1 : n.End()-1])
+	}
+
+	param, _ := fuzzParam(fn)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if ident, isIdent := sel.X.(*ast.Ident); !isIdent ||
+			ident.Name != param {
+			return true
+		}
+
+		switch sel.Sel.Name {
+		case "Add":
+			seed := &entry{
+				name:   fmt.Sprintf("seed#%d", len(t.seeds)),
+				values: nil,
+				isNew:  false,
+			}
+
+			for _, arg := range call.Args {
+				seed.values = append(seed.values, valueText(arg, source))
+			}
+
+			t.seeds = append(t.seeds, seed)
+		case "Fuzz":
+			if len(call.Args) == 1 {
+				if lit, isLit := call.Args[0].(*ast.FuncLit); isLit {
+					t.params = funcLitParams(lit)
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// findTarget locates the fuzz target among the package's test files
+// collecting its seed corpus.
+func findTarget(dir, name string) (*target, error) {
+	var (
+		files []string
+		src   []byte
+		file  *ast.File
+		tgt   *target
+		err   error
+	)
+
+	files, err = filepath.Glob(filepath.Join(dir, "*_test.go"))
+
+	for i, mi := 0, len(files); i < mi && err == nil && tgt == nil; i++ {
+		src, err = os.ReadFile(files[i]) //nolint:gosec // Ok.
+		if err == nil {
+			file, err = parser.ParseFile(
+				token.NewFileSet(), files[i], src, parser.SkipObjectResolution,
+			)
+		}
+
+		for j := 0; err == nil && tgt == nil && j < len(file.Decls); j++ {
+			fn, ok := file.Decls[j].(*ast.FuncDecl)
+			if !ok || fn.Name.Name != name || fn.Body == nil {
+				continue
+			}
+
+			if _, ok = fuzzParam(fn); ok {
+				tgt = &target{params: nil, seeds: nil}
+				tgt.inspect(fn, src)
+			}
+		}
+	}
+
+	if err == nil && tgt == nil {
+		err = fmt.Errorf("%w: %q", errs.ErrUnknownFunction, name)
+	}
+
+	if err == nil {
+		return tgt, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
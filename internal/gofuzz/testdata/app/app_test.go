@@ -0,0 +1,27 @@
+package app
+
+import "testing"
+
+func FuzzParse(f *testing.F) {
+	f.Add("a|b", 1)
+	f.Add(`raw`, -2)
+	f.Fuzz(func(t *testing.T, input string, n int) {})
+}
+
+func FuzzSeed(f *testing.F) {
+	f.Add([]byte("boom"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if string(data) == "boom" {
+			t.Fatal("boom")
+		}
+	})
+}
+
+func FuzzEmpty(f *testing.F) {
+	f.Add("")
+	f.Fuzz(func(t *testing.T, s string) {
+		if s != "" {
+			t.Fatal("not empty")
+		}
+	})
+}
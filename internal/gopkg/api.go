@@ -0,0 +1,244 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg
+
+import (
+	"go/types"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// APIEntry describes a single exported declaration.  The Key identifies the
+// declaration (for example "method Type.Name") while Decl holds its complete
+// signature.
+type APIEntry struct {
+	Key  string
+	Decl string
+}
+
+func isKeyRune(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func keyName(s string) string {
+	if i := strings.IndexFunc(s, func(r rune) bool {
+		return !isKeyRune(r)
+	}); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
+
+// APIKey returns the key identifying the declaration produced by API.  Two
+// declarations with the same key describe the same object so differing
+// declarations represent a change to that object.
+func APIKey(decl string) string {
+	kind, rest, _ := strings.Cut(decl, " ")
+
+	if kind == "method" {
+		recv, name, _ := strings.Cut(rest, " ")
+
+		return kind + " " + strings.Trim(recv, "(*)") + "." + keyName(name)
+	}
+
+	return kind + " " + keyName(rest)
+}
+
+type apiCollector struct {
+	qualifier types.Qualifier
+	entries   []APIEntry
+}
+
+func (c *apiCollector) add(decl string) {
+	c.entries = append(c.entries, APIEntry{Key: APIKey(decl), Decl: decl})
+}
+
+func (c *apiCollector) typeString(t types.Type) string {
+	return types.TypeString(t, c.qualifier)
+}
+
+func (c *apiCollector) tuple(tuple *types.Tuple, variadic bool) []string {
+	list := make([]string, 0, tuple.Len())
+
+	for v := range tuple.Variables() {
+		list = append(list, c.typeString(v.Type()))
+	}
+
+	if variadic && len(list) > 0 {
+		last := tuple.At(tuple.Len() - 1).Type()
+		if slice, ok := last.(*types.Slice); ok {
+			list[len(list)-1] = "..." + c.typeString(slice.Elem())
+		}
+	}
+
+	return list
+}
+
+// signature renders the signature omitting parameter names as renaming a
+// parameter does not change the API.
+func (c *apiCollector) signature(sig *types.Signature) string {
+	var res strings.Builder
+
+	if tParams := sig.TypeParams(); tParams.Len() > 0 {
+		list := make([]string, 0, tParams.Len())
+		for tp := range tParams.TypeParams() {
+			list = append(list,
+				tp.Obj().Name()+" "+c.typeString(tp.Constraint()),
+			)
+		}
+
+		res.WriteString("[" + strings.Join(list, ", ") + "]")
+	}
+
+	res.WriteString(
+		"(" + strings.Join(c.tuple(sig.Params(), sig.Variadic()), ", ") + ")",
+	)
+
+	switch results := c.tuple(sig.Results(), false); len(results) {
+	case 0:
+	case 1:
+		res.WriteString(" " + results[0])
+	default:
+		res.WriteString(" (" + strings.Join(results, ", ") + ")")
+	}
+
+	return res.String()
+}
+
+func (c *apiCollector) addStruct(name string, st *types.Struct) {
+	c.add("type " + name + " struct")
+
+	for field := range st.Fields() {
+		if !field.Exported() {
+			continue
+		}
+
+		kind := "field "
+		if field.Embedded() {
+			kind = "embedded "
+		}
+
+		c.add(kind + name + "." + field.Name() + " " +
+			c.typeString(field.Type()),
+		)
+	}
+}
+
+func (c *apiCollector) addInterface(name string, it *types.Interface) {
+	c.add("type " + name + " interface")
+
+	for i, mi := 0, it.NumExplicitMethods(); i < mi; i++ {
+		m := it.ExplicitMethod(i)
+		if !m.Exported() {
+			continue
+		}
+
+		c.add("method (" + name + ") " + m.Name() + c.signature(m.Signature()))
+	}
+
+	for i, mi := 0, it.NumEmbeddeds(); i < mi; i++ {
+		c.add("embedded " + name + "." + c.typeString(it.EmbeddedType(i)))
+	}
+}
+
+func (c *apiCollector) addMethods(name string, named *types.Named) {
+	for m := range named.Methods() {
+		if !m.Exported() {
+			continue
+		}
+
+		sig := m.Signature()
+		recv := name
+
+		if _, isPtr := sig.Recv().Type().(*types.Pointer); isPtr {
+			recv = "*" + name
+		}
+
+		c.add("method (" + recv + ") " + m.Name() + c.signature(sig))
+	}
+}
+
+func (c *apiCollector) addType(obj *types.TypeName) {
+	name := obj.Name()
+
+	if alias, ok := obj.Type().(*types.Alias); ok {
+		c.add("type " + name + " = " + c.typeString(alias.Rhs()))
+
+		return
+	}
+
+	switch underlying := obj.Type().Underlying().(type) {
+	case *types.Struct:
+		c.addStruct(name, underlying)
+	case *types.Interface:
+		c.addInterface(name, underlying)
+	default:
+		c.add("type " + name + " " + c.typeString(underlying))
+	}
+
+	if named, ok := obj.Type().(*types.Named); ok {
+		c.addMethods(name, named)
+	}
+}
+
+func (c *apiCollector) addObject(obj types.Object) {
+	name := obj.Name()
+
+	switch obj := obj.(type) {
+	case *types.Func:
+		c.add("func " + name + c.signature(obj.Signature()))
+	case *types.Const:
+		c.add("const " + name + " " + c.typeString(obj.Type()) + " = " +
+			obj.Val().ExactString(),
+		)
+	case *types.Var:
+		c.add("var " + name + " " + c.typeString(obj.Type()))
+	case *types.TypeName:
+		c.addType(obj)
+	}
+}
+
+// API returns the import path and all exported declarations of the package
+// in the directory sorted by key.
+func API(dir string, build Build) (string, []APIEntry, error) {
+	pkgInfo, err := cachedPackageInfo(dir, build)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pkg := pkgInfo.typesPkg
+	c := &apiCollector{
+		qualifier: types.RelativeTo(pkg),
+		entries:   nil,
+	}
+
+	for _, name := range pkg.Scope().Names() {
+		if obj := pkg.Scope().Lookup(name); obj.Exported() {
+			c.addObject(obj)
+		}
+	}
+
+	sort.SliceStable(c.entries, func(i, j int) bool {
+		return c.entries[i].Key < c.entries[j].Key
+	})
+
+	return pkg.Path(), c.entries, nil
+}
@@ -0,0 +1,111 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GoPackage_API(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	pkgPath, entries, err := gopkg.API("./testdata/api", gopkg.Build{})
+	chk.NoErr(err)
+	chk.Str(pkgPath, "github.com/dancsecs/gotomd/internal/gopkg/testdata/api")
+
+	keys := make([]string, len(entries))
+	decls := make([]string, len(entries))
+
+	for i, entry := range entries {
+		keys[i] = entry.Key
+		decls[i] = entry.Decl
+		chk.Str(gopkg.APIKey(entry.Decl), entry.Key)
+	}
+
+	chk.StrSlice(
+		keys,
+		[]string{
+			"const Greeting",
+			"const Limit",
+			"embedded Shape.io.Closer",
+			"embedded Widget.Reader",
+			"field Widget.Name",
+			"field Widget.Sizes",
+			"func Max",
+			"func New",
+			"method Shape.Area",
+			"method Shape.Scale",
+			"method Widget.Count",
+			"method Widget.Rename",
+			"type Alias",
+			"type Shape",
+			"type Size",
+			"type Widget",
+			"var Default",
+		},
+	)
+
+	chk.StrSlice(
+		decls,
+		[]string{
+			`const Greeting untyped string = "hello"`,
+			"const Limit Size = 10",
+			"embedded Shape.io.Closer",
+			"embedded Widget.Reader io.Reader",
+			"field Widget.Name string",
+			"field Widget.Sizes []Size",
+			"func Max[T int | float64](...T) T",
+			"func New(string) *Widget",
+			"method (Shape) Area() float64",
+			"method (Shape) Scale(float64, ...float64) (Shape, error)",
+			"method (Widget) Count() int",
+			"method (*Widget) Rename(string)",
+			"type Alias = Size",
+			"type Shape interface",
+			"type Size int",
+			"type Widget struct",
+			"var Default *Widget",
+		},
+	)
+
+	chk.Stdout(
+		"Loading package info for: ./testdata/api",
+	)
+}
+
+func Test_GoPackage_API_Invalid(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	_, _, err := gopkg.API("./testdata/unknown", gopkg.Build{})
+	chk.Err(err, errs.ErrInvalidPackage.Error())
+
+	chk.Stdout(
+		"Loading package info for: ./testdata/unknown",
+	)
+}
@@ -0,0 +1,116 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg
+
+import (
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/cmds"
+)
+
+// Options selecting the build constraints used to load packages.
+const (
+	OptTags   = "tags"
+	OptGOOS   = "goos"
+	OptGOARCH = "goarch"
+)
+
+// Build identifies the build constraints used to select the files making
+// up a package.  Empty fields use the go tool's defaults.
+type Build struct {
+	Tags   string
+	GOOS   string
+	GOARCH string
+}
+
+// key returns a string uniquely identifying the build for caching.
+func (b Build) key() string {
+	return b.GOOS + "/" + b.GOARCH + "/" + b.normalizedTags()
+}
+
+// normalizedTags returns the tags as a comma separated list regardless of
+// whether they were supplied separated by spaces or commas.
+func (b Build) normalizedTags() string {
+	return strings.Join(
+		strings.FieldsFunc(b.Tags, func(r rune) bool {
+			return r == ',' || r == ' '
+		}),
+		",",
+	)
+}
+
+// Env returns the environment settings required by the build.
+func (b Build) Env() []string {
+	var env []string
+
+	if b.GOOS != "" {
+		env = append(env, "GOOS="+b.GOOS)
+	}
+
+	if b.GOARCH != "" {
+		env = append(env, "GOARCH="+b.GOARCH)
+	}
+
+	return env
+}
+
+// Flags returns the go build flags required by the build.
+func (b Build) Flags() []string {
+	if tags := b.normalizedTags(); tags != "" {
+		return []string{"-tags=" + tags}
+	}
+
+	return nil
+}
+
+// String describes the build for messages.
+func (b Build) String() string {
+	var res []string
+
+	if b.Tags != "" {
+		res = append(res, "tags="+b.normalizedTags())
+	}
+
+	if b.GOOS != "" {
+		res = append(res, "goos="+b.GOOS)
+	}
+
+	if b.GOARCH != "" {
+		res = append(res, "goarch="+b.GOARCH)
+	}
+
+	return strings.Join(res, " ")
+}
+
+// BuildOptions returns the names of the build options followed by any
+// additional options a directive accepts.
+func BuildOptions(extra ...string) []string {
+	return append([]string{OptTags, OptGOOS, OptGOARCH}, extra...)
+}
+
+// BuildFromOptions returns the build selected by the options defaulting to
+// the build constraints supplied on the command line.
+func BuildFromOptions(opts cmds.Options) Build {
+	return Build{
+		Tags:   opts.Value(OptTags, args.BuildTags()),
+		GOOS:   opts.Value(OptGOOS, args.BuildGOOS()),
+		GOARCH: opts.Value(OptGOARCH, args.BuildGOARCH()),
+	}
+}
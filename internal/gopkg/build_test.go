@@ -0,0 +1,141 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+const taggedPath = "./testdata/tagged"
+
+func Test_GoPackage_InfoFor_Tags(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	data, err := gopkg.InfoFor(taggedPath, "Variant", gopkg.Build{})
+	chk.NoErr(err)
+	chk.Str(data.Comment(), "Variant returns the default variant.")
+
+	data, err = gopkg.InfoFor(
+		taggedPath, "Variant", gopkg.Build{Tags: "integration"},
+	)
+	chk.NoErr(err)
+	chk.Str(data.Comment(), "Variant returns the integration variant.")
+
+	// Cached separately.
+	data, err = gopkg.Info(taggedPath, "Variant")
+	chk.NoErr(err)
+	chk.Str(data.Comment(), "Variant returns the default variant.")
+
+	data, err = gopkg.InfoFor(
+		taggedPath, "Variant", gopkg.Build{Tags: "other integration"},
+	)
+	chk.NoErr(err)
+	chk.Str(data.Comment(), "Variant returns the integration variant.")
+
+	chk.Stdout(
+		"Loading package info for: "+taggedPath,
+		"getInfo(\"Variant\")",
+		"Loading package info for: "+taggedPath+" (tags=integration)",
+		"getInfo(\"Variant\")",
+		"getInfo(\"Variant\")",
+		"Loading package info for: "+taggedPath+
+			" (tags=other,integration)",
+		"getInfo(\"Variant\")",
+	)
+}
+
+func Test_GoPackage_InfoFor_Platform(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	data, err := gopkg.InfoFor(
+		taggedPath,
+		"PathSeparator",
+		gopkg.Build{GOOS: "linux", GOARCH: "amd64"},
+	)
+	chk.NoErr(err)
+	chk.Str(data.OneLine(), `const PathSeparator = "/"`)
+
+	data, err = gopkg.InfoFor(
+		taggedPath,
+		"PathSeparator",
+		gopkg.Build{GOOS: "windows", GOARCH: "amd64"},
+	)
+	chk.NoErr(err)
+	chk.Str(data.OneLine(), "const PathSeparator = `\\`")
+	chk.Str(
+		data.Comment(),
+		"PathSeparator is the separator used by the windows platform.",
+	)
+
+	_, err = gopkg.InfoFor(
+		taggedPath,
+		"PathSeparator",
+		gopkg.Build{GOOS: "INVALID_OS"},
+	)
+	chk.Err(err, errs.ErrInvalidPackage.Error())
+
+	chk.Stdout(
+		"Loading package info for: "+taggedPath+" (goos=linux goarch=amd64)",
+		"getInfo(\"PathSeparator\")",
+		"Loading package info for: "+taggedPath+
+			" (goos=windows goarch=amd64)",
+		"getInfo(\"PathSeparator\")",
+		"Loading package info for: "+taggedPath+" (goos=INVALID_OS)",
+	)
+}
+
+func Test_GoPackage_BuildFromOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.StrSlice(
+		gopkg.BuildOptions("min"),
+		[]string{"tags", "goos", "goarch", "min"},
+	)
+
+	chk.SetArgs("noProgName", "--tags", "extra", "--goos", "windows", ".")
+	chk.NoErr(args.Process())
+	chk.PushPostReleaseFunc(func() error {
+		args.Reset()
+
+		return nil
+	})
+
+	_, opts, err := cmds.ExtractOptions(
+		"./. tags=integration goarch=arm64", gopkg.BuildOptions()...,
+	)
+	chk.NoErr(err)
+
+	build := gopkg.BuildFromOptions(opts)
+	chk.Str(build.Tags, "integration")
+	chk.Str(build.GOOS, "windows")
+	chk.Str(build.GOARCH, "arm64")
+}
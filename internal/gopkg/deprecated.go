@@ -0,0 +1,138 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg
+
+import (
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const deprecatedPrefix = "Deprecated: "
+
+// Patterns used to locate the suggested replacement of a deprecated
+// identifier: a doc link ("[NewName]") or an instruction ("Use NewName").
+var (
+	docLinkRe = regexp.MustCompile(`\[\*?([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)?)\]`)
+	useRe     = regexp.MustCompile(`\b[Uu]se\s+\*?([A-Za-z_]\w*(?:\.\w+)?)`)
+)
+
+// Deprecation describes an exported identifier documented as deprecated.
+// Replacement holds the name of the suggested replacement if it could be
+// resolved to an exported identifier of the same package along with the
+// name of the file (within the package directory) and line declaring it.
+type Deprecation struct {
+	Kind            string
+	Name            string
+	Note            string
+	Replacement     string
+	ReplacementFile string
+	ReplacementLine int
+}
+
+// DeprecationNote returns the text of the "Deprecated:" paragraph of a doc
+// comment joined into a single line or an empty string if there is none.
+func DeprecationNote(comment string) string {
+	var (
+		note       []string
+		collecting bool
+	)
+
+	for line := range strings.SplitSeq(comment, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case collecting && line == "":
+			return strings.Join(note, " ")
+		case collecting:
+			note = append(note, line)
+		case strings.HasPrefix(line, deprecatedPrefix):
+			collecting = true
+
+			note = append(note, strings.TrimPrefix(line, deprecatedPrefix))
+		}
+	}
+
+	return strings.Join(note, " ")
+}
+
+func resolveReplacement(
+	note string, names map[string]token.Pos,
+) (string, token.Pos) {
+	for _, re := range []*regexp.Regexp{docLinkRe, useRe} {
+		for _, match := range re.FindAllStringSubmatch(note, -1) {
+			if pos, ok := names[match[1]]; ok {
+				return match[1], pos
+			}
+		}
+	}
+
+	return "", token.NoPos
+}
+
+// Deprecations returns the import path and all exported identifiers of the
+// package in the directory whose doc comments contain a "Deprecated:"
+// paragraph.
+func Deprecations(dir string, build Build) (string, []Deprecation, error) {
+	var (
+		pkgInfo *packageInfo
+		list    []Deprecation
+		names   = make(map[string]token.Pos)
+		err     error
+	)
+
+	pkgInfo, err = cachedPackageInfo(dir, build)
+	if err != nil {
+		return "", nil, err
+	}
+
+	docs := exported(pkgInfo.docPkg)
+	for _, e := range docs {
+		if e.kind != pkgLabel {
+			names[e.name] = e.pos
+		}
+	}
+
+	for _, e := range docs {
+		if note := DeprecationNote(e.comment); note != "" {
+			var (
+				file string
+				line int
+			)
+
+			replacement, pos := resolveReplacement(note, names)
+			if pos.IsValid() {
+				position := pkgInfo.fSet.Position(pos)
+				file, line = filepath.Base(position.Filename), position.Line
+			}
+
+			list = append(list, Deprecation{
+				Kind:            e.kind,
+				Name:            e.name,
+				Note:            note,
+				Replacement:     replacement,
+				ReplacementFile: file,
+				ReplacementLine: line,
+			})
+		}
+	}
+
+	return pkgInfo.typesPkg.Path(), list, nil
+}
@@ -0,0 +1,118 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GoPackage_DeprecationNote(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Str(gopkg.DeprecationNote(""), "")
+	chk.Str(gopkg.DeprecationNote("Not deprecated.\n"), "")
+	chk.Str(
+		gopkg.DeprecationNote("Old is old.\n\nDeprecated: Use New.\n"),
+		"Use New.",
+	)
+	chk.Str(
+		gopkg.DeprecationNote(""+
+			"Old is old.\n\n"+
+			"Deprecated: Use New.\n"+
+			"  It is better.\n\n"+
+			"More.\n",
+		),
+		"Use New. It is better.",
+	)
+}
+
+func Test_GoPackage_Deprecations(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	pkgPath, list, err := gopkg.Deprecations(
+		"./testdata/deprecated", gopkg.Build{},
+	)
+	chk.NoErr(err)
+	chk.Str(
+		pkgPath,
+		"github.com/dancsecs/gotomd/internal/gopkg/testdata/deprecated",
+	)
+
+	got := make([]string, len(list))
+	for i, d := range list {
+		got[i] = d.Kind + " " + d.Name + ": " + d.Note + " => " +
+			d.Replacement
+
+		if d.ReplacementFile != "" {
+			got[i] += " (" + d.ReplacementFile + ":" +
+				strconv.Itoa(d.ReplacementLine) + ")"
+		}
+	}
+
+	chk.StrSlice(
+		got,
+		[]string{
+			"const OldLimit: Use [Limit] instead. => Limit (deprecated.go:24)",
+			"func Gone: Use strings.Clone | copy instead. => ",
+			"func MakeWidget: Use NewWidget which returns a pointer.  " +
+				"This function will be removed in the next major version." +
+				" => NewWidget (deprecated.go:35)",
+			"method Widget.Len: Use Widget.Size instead. => Widget.Size" +
+				" (deprecated.go:50)",
+		},
+	)
+
+	_, _, err = gopkg.Deprecations("./testdata/unknown", gopkg.Build{})
+	chk.Err(err, errs.ErrInvalidPackage.Error())
+
+	chk.Stdout(
+		"Loading package info for: ./testdata/deprecated",
+		"Loading package info for: ./testdata/unknown",
+	)
+}
+
+func Test_GoPackage_DocInfo_Deprecation(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	dInfo, err := gopkg.Info("./testdata/deprecated", "OldLimit")
+	chk.NoErr(err)
+	chk.Str(dInfo.Deprecation(), "Use [Limit] instead.")
+
+	dInfo, err = gopkg.Info("./testdata/deprecated", "Limit")
+	chk.NoErr(err)
+	chk.Str(dInfo.Deprecation(), "")
+
+	chk.Stdout(
+		"Loading package info for: ./testdata/deprecated",
+		"getInfo(\"OldLimit\")",
+		"getInfo(\"Limit\")",
+	)
+}
@@ -0,0 +1,246 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"golang.org/x/tools/go/packages"
+)
+
+// Reasons an exported identifier is reported.
+const (
+	ReasonMissing  = "missing doc comment"
+	ReasonNoPrefix = "doc comment should start with"
+)
+
+// DocIssue describes an exported identifier without a conventional doc
+// comment.
+type DocIssue struct {
+	Kind   string
+	Name   string
+	Reason string
+}
+
+// String describes the issue (for example `func Run: missing doc comment`).
+func (i DocIssue) String() string {
+	return i.Kind + " " + i.Name + ": " + i.Reason
+}
+
+// DocCoverage summarizes the documentation of the exported identifiers in a
+// single package.
+type DocCoverage struct {
+	PkgPath    string
+	Total      int
+	Documented int
+	Issues     []DocIssue
+}
+
+// Percent returns the percentage of exported identifiers conventionally
+// documented.  A package without exported identifiers is fully documented.
+func (c *DocCoverage) Percent() float64 {
+	if c.Total == 0 {
+		return 100 //nolint:mnd // Percent.
+	}
+
+	return float64(c.Documented) * 100 / float64(c.Total) //nolint:mnd // Ok.
+}
+
+// check records an exported identifier and any issue with its comment.  By
+// convention the comment must start with the name of the identifier (types
+// may be preceded by an article).
+func (c *DocCoverage) check(kind, name, prefix, comment string) {
+	c.Total++
+
+	reason := ""
+
+	switch {
+	case strings.TrimSpace(comment) == "":
+		reason = ReasonMissing
+	case !hasPrefixWord(comment, prefix, kind == "type"):
+		reason = ReasonNoPrefix + " \"" + prefix + "\""
+	}
+
+	if reason == "" {
+		c.Documented++
+
+		return
+	}
+
+	c.Issues = append(c.Issues, DocIssue{
+		Kind:   kind,
+		Name:   name,
+		Reason: reason,
+	})
+}
+
+func hasPrefixWord(comment, prefix string, allowArticle bool) bool {
+	if prefix == "" {
+		return true
+	}
+
+	if allowArticle {
+		for _, article := range []string{"A ", "An ", "The "} {
+			comment = strings.TrimPrefix(comment, article)
+		}
+	}
+
+	rest, ok := strings.CutPrefix(comment, prefix)
+
+	return ok && (rest == "" || !isKeyRune([]rune(rest)[0]))
+}
+
+// DocCoverageFor reports the exported identifiers of the package in the
+// directory lacking a doc comment starting with their name.
+func DocCoverageFor(dir string, build Build) (*DocCoverage, error) {
+	pkgInfo, err := cachedPackageInfo(dir, build)
+	if err != nil {
+		return nil, err
+	}
+
+	docPkg := pkgInfo.docPkg
+	cov := &DocCoverage{
+		PkgPath:    pkgInfo.typesPkg.Path(),
+		Total:      0,
+		Documented: 0,
+		Issues:     nil,
+	}
+
+	for _, e := range exported(docPkg) {
+		cov.check(e.kind, e.name, e.prefix, e.comment)
+	}
+
+	return cov, nil
+}
+
+// matchingPackages loads the packages matching the directory ("./dir/."
+// for the single package or "./dir/..." for all packages below it) that
+// contain files selected by the build.
+func matchingPackages(
+	dir, action string, build Build,
+) ([]*packages.Package, error) {
+	var (
+		modDir string
+		relDir string
+		wsEnv  []string
+		pkgs   []*packages.Package
+		found  []*packages.Package
+		err    error
+	)
+
+	modDir, relDir, err = gowork.Locate(dir)
+	if err == nil {
+		wsEnv, err = gowork.Env(dir)
+	}
+
+	if err == nil {
+		pattern := relDir
+		if action == "..." {
+			pattern = relDir + string(os.PathSeparator) + action
+		}
+
+		cfg := new(packages.Config)
+		cfg.Dir = modDir
+		cfg.Env = append(append(os.Environ(), wsEnv...), build.Env()...)
+		cfg.BuildFlags = build.Flags()
+		cfg.Mode = packages.NeedName | packages.NeedFiles
+		cfg.Tests = false
+
+		pkgs, err = proc.LoadPackages(cfg, pattern)
+	}
+
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) > 0 {
+			found = append(found, pkg)
+		}
+	}
+
+	if err == nil && len(found) == 0 {
+		err = errs.ErrInvalidPackage
+	}
+
+	if err == nil {
+		return found, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// PackageDirs returns the directories (relative to the current directory)
+// of all packages matching the directory ("./dir/." for the single package
+// or "./dir/..." for all packages below it) that contain files selected by
+// the build.
+func PackageDirs(dir, action string, build Build) ([]string, error) {
+	var (
+		cwd     string
+		pkgs    []*packages.Package
+		pkgDirs []string
+		err     error
+	)
+
+	cwd, err = os.Getwd()
+
+	if err == nil {
+		pkgs, err = matchingPackages(dir, action, build)
+	}
+
+	for i, mi := 0, len(pkgs); i < mi && err == nil; i++ {
+		var rel string
+
+		rel, err = filepath.Rel(cwd, filepath.Dir(pkgs[i].GoFiles[0]))
+		if err == nil && rel != "." && filepath.IsLocal(rel) {
+			rel = "." + string(os.PathSeparator) + rel
+		}
+
+		if err == nil {
+			pkgDirs = append(pkgDirs, rel)
+		}
+	}
+
+	if err == nil {
+		sort.Strings(pkgDirs)
+
+		return pkgDirs, nil
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// ImportDirs maps the import path of each package matching the directory
+// (as for PackageDirs) to its absolute directory.
+func ImportDirs(dir, action string, build Build) (map[string]string, error) {
+	pkgs, err := matchingPackages(dir, action, build)
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := make(map[string]string, len(pkgs))
+
+	for _, pkg := range pkgs {
+		dirs[pkg.PkgPath] = filepath.Dir(pkg.GoFiles[0])
+	}
+
+	return dirs, nil
+}
@@ -0,0 +1,120 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GoPackage_DocCoverage(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	cov, err := gopkg.DocCoverageFor("./testdata/doccov", gopkg.Build{})
+	chk.NoErr(err)
+	chk.Str(
+		cov.PkgPath,
+		"github.com/dancsecs/gotomd/internal/gopkg/testdata/doccov",
+	)
+	chk.Int(cov.Total, 14)
+	chk.Int(cov.Documented, 7)
+	chk.Float64(cov.Percent(), 50, 0)
+
+	issues := make([]string, len(cov.Issues))
+	for i, issue := range cov.Issues {
+		issues[i] = issue.String()
+	}
+
+	chk.StrSlice(
+		issues,
+		[]string{
+			"package doccov: missing doc comment",
+			"const Undocumented: missing doc comment",
+			`var Wrong: doc comment should start with "Wrong"`,
+			`func Run: doc comment should start with "Run"`,
+			"func Undocumented2: missing doc comment",
+			"type Bare: missing doc comment",
+			"method Widget.Size: missing doc comment",
+		},
+	)
+
+	chk.Stdout(
+		"Loading package info for: ./testdata/doccov",
+	)
+}
+
+func Test_GoPackage_DocCoverage_Complete(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+
+	cov, err := gopkg.DocCoverageFor("./testdata/api", gopkg.Build{})
+	chk.NoErr(err)
+	chk.Int(cov.Total, cov.Documented)
+	chk.Float64(cov.Percent(), 100, 0)
+	chk.Int(len(cov.Issues), 0)
+
+	cov = new(gopkg.DocCoverage)
+	chk.Float64(cov.Percent(), 100, 0)
+
+	chk.Stdout(
+		"Loading package info for: ./testdata/api",
+	)
+}
+
+func Test_GoPackage_PackageDirs(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	t.Chdir("..")
+
+	dirs, err := gopkg.PackageDirs("./gopkg", ".", gopkg.Build{})
+	chk.NoErr(err)
+	chk.StrSlice(dirs, []string{"./gopkg"})
+
+	dirs, err = gopkg.PackageDirs("./gowork", "...", gopkg.Build{})
+	chk.NoErr(err)
+	chk.StrSlice(dirs, []string{"./gowork"})
+
+	dirs, err = gopkg.PackageDirs(".", ".", gopkg.Build{})
+	chk.NoErr(err)
+	chk.StrSlice(dirs, []string{"."})
+
+	_, err = gopkg.PackageDirs("./gopkg/testdata", ".", gopkg.Build{})
+	chk.Err(err, errs.ErrInvalidPackage.Error())
+
+	// Packages only built with a tag.
+	_, err = gopkg.PackageDirs("./gopkg/testdata/tagonly", ".", gopkg.Build{})
+	chk.Err(err, errs.ErrInvalidPackage.Error())
+
+	dirs, err = gopkg.PackageDirs(
+		"./gopkg/testdata/tagonly", ".", gopkg.Build{Tags: "integration"},
+	)
+	chk.NoErr(err)
+	chk.StrSlice(dirs, []string{"./gopkg/testdata/tagonly"})
+
+	chk.Stdout()
+}
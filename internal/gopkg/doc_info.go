@@ -102,6 +102,12 @@ func (di *DocInfo) Comment() string {
 	return strings.Join(di.doc, "\n")
 }
 
+// Deprecation returns the object's "Deprecated:" note or an empty string if
+// it is not deprecated.
+func (di *DocInfo) Deprecation() string {
+	return DeprecationNote(di.Comment())
+}
+
 // ConstantBlock returns a constant block formatted as it would in a go
 // source file.
 func (di *DocInfo) ConstantBlock() string {
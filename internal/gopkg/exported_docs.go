@@ -0,0 +1,139 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg
+
+import (
+	"go/ast"
+	"go/doc"
+	"go/token"
+)
+
+// exportedDoc describes an exported identifier, its doc comment and where
+// it is declared.  The prefix is the word the comment is conventionally
+// expected to start with (empty when the comment may describe a whole block
+// of values).
+type exportedDoc struct {
+	kind    string
+	name    string
+	prefix  string
+	comment string
+	pos     token.Pos
+}
+
+type exportedDocs []exportedDoc
+
+func (e *exportedDocs) add(
+	kind, name, prefix, comment string, pos token.Pos,
+) {
+	*e = append(*e, exportedDoc{
+		kind:    kind,
+		name:    name,
+		prefix:  prefix,
+		comment: comment,
+		pos:     pos,
+	})
+}
+
+func (e *exportedDocs) addFuncs(funcs []*doc.Func, recv string) {
+	for _, f := range funcs {
+		if !token.IsExported(f.Name) || f.Level > 0 {
+			continue
+		}
+
+		if recv == "" {
+			e.add("func", f.Name, f.Name, f.Doc, f.Decl.Name.Pos())
+		} else {
+			e.add("method", recv+"."+f.Name, f.Name, f.Doc, f.Decl.Name.Pos())
+		}
+	}
+}
+
+func (e *exportedDocs) addValues(kind string, values []*doc.Value) {
+	for _, value := range values {
+		grouped := value.Decl.Lparen.IsValid()
+
+		for _, spec := range value.Decl.Specs {
+			vSpec, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+
+			comment := value.Doc
+			if vSpec.Doc != nil {
+				comment = vSpec.Doc.Text()
+			}
+
+			for _, ident := range vSpec.Names {
+				if !token.IsExported(ident.Name) {
+					continue
+				}
+
+				prefix := ident.Name
+				if grouped {
+					// Comments within blocks may describe several values.
+					prefix = ""
+				}
+
+				e.add(kind, ident.Name, prefix, comment, ident.Pos())
+			}
+		}
+	}
+}
+
+// typePos returns the position of the type's name in its declaration.
+func typePos(t *doc.Type) token.Pos {
+	for _, spec := range t.Decl.Specs {
+		if tSpec, ok := spec.(*ast.TypeSpec); ok && tSpec.Name.Name == t.Name {
+			return tSpec.Name.Pos()
+		}
+	}
+
+	return t.Decl.Pos()
+}
+
+func (e *exportedDocs) addTypes(types []*doc.Type) {
+	for _, t := range types {
+		e.addValues("const", t.Consts)
+		e.addValues("var", t.Vars)
+		e.addFuncs(t.Funcs, "")
+
+		if !token.IsExported(t.Name) {
+			continue
+		}
+
+		e.add("type", t.Name, t.Name, t.Doc, typePos(t))
+		e.addFuncs(t.Methods, t.Name)
+	}
+}
+
+// exported lists the package and all of its exported identifiers in
+// documentation order.
+func exported(docPkg *doc.Package) exportedDocs {
+	var e exportedDocs
+
+	e.add(pkgLabel, docPkg.Name, "Package "+docPkg.Name, docPkg.Doc,
+		token.NoPos,
+	)
+	e.addValues("const", docPkg.Consts)
+	e.addValues("var", docPkg.Vars)
+	e.addFuncs(docPkg.Funcs, "")
+	e.addTypes(docPkg.Types)
+
+	return e
+}
@@ -0,0 +1,110 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg
+
+import (
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"os"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+const elidedBody = "{ ... }"
+
+// elidedSource returns the function's source with the body of each
+// (outermost) function literal it contains replaced by "{ ... }".
+func (pi *packageInfo) elidedSource(docFunc *doc.Func) ([]string, error) {
+	var (
+		cuts [][2]int
+		res  strings.Builder
+	)
+
+	fStart := pi.fSet.PositionFor(docFunc.Decl.Pos(), true)
+	fEnd := pi.fSet.PositionFor(docFunc.Decl.End(), true)
+
+	data, err := os.ReadFile(fStart.Filename) //nolint:gosec // Ok.
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	ast.Inspect(docFunc.Decl.Body, func(n ast.Node) bool {
+		lit, ok := n.(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+
+		cuts = append(cuts, [2]int{
+			pi.fSet.PositionFor(lit.Body.Lbrace, true).Offset,
+			pi.fSet.PositionFor(lit.Body.Rbrace, true).Offset + 1,
+		})
+
+		return false // Nested literals are removed with their parent.
+	})
+
+	last := fStart.Offset
+	for _, cut := range cuts {
+		res.Write(data[last:cut[0]])
+		res.WriteString(elidedBody)
+
+		last = cut[1]
+	}
+
+	res.Write(data[last:fEnd.Offset])
+
+	return leadingTabsToSpaces(strings.Split(res.String(), "\n")), nil
+}
+
+// FuncInfo returns the documentation information of the named function or
+// method ("Type.Method") whose Body contains its complete source.  When
+// elide is true the bodies of any function literals are replaced by
+// "{ ... }".
+func FuncInfo(dir, name string, build Build, elide bool) (*DocInfo, error) {
+	var (
+		pkgInfo *packageInfo
+		docFunc *doc.Func
+		dInfo   *DocInfo
+		err     error
+	)
+
+	pkgInfo, err = cachedPackageInfo(dir, build)
+
+	if err == nil {
+		docFunc = pkgInfo.findFunc(name)
+		if docFunc == nil || docFunc.Decl.Body == nil {
+			err = fmt.Errorf("%w: %s", errs.ErrUnknownFunction, name)
+		}
+	}
+
+	if err == nil {
+		dInfo, err = pkgInfo.funcInfo(docFunc)
+	}
+
+	if err == nil && elide {
+		dInfo.body, err = pkgInfo.elidedSource(docFunc)
+	}
+
+	if err == nil {
+		return dInfo, nil
+	}
+
+	return nil, err
+}
@@ -0,0 +1,54 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gopkg_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_GoPackage_FuncInfo(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	gopkg.Reset()
+	defer gopkg.Reset()
+
+	dInfo, err := gopkg.FuncInfo(samplePath, "TimesTwo", gopkg.Build{}, true)
+	chk.NoErr(err)
+	chk.StrSlice(
+		dInfo.Body(),
+		[]string{
+			"func TimesTwo(i int) int {",
+			"    return i + i",
+			"}",
+		},
+	)
+	chk.Str(dInfo.Comment(), "TimesTwo returns the value times two.")
+
+	_, err = gopkg.FuncInfo(samplePath, "StructureType", gopkg.Build{}, false)
+	chk.Err(err, chk.ErrChain(errs.ErrUnknownFunction, "StructureType"))
+
+	chk.Stdout(
+		"Loading package info for: " + samplePath,
+	)
+}
@@ -22,11 +22,14 @@ import (
 	"fmt"
 	"go/doc"
 	"go/token"
+	"go/types"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/proc"
 	"github.com/dancsecs/szlog"
 	"golang.org/x/tools/go/packages"
 )
@@ -36,6 +39,7 @@ const pkgLabel = "package"
 type packageInfo struct {
 	fSet      *token.FileSet
 	docPkg    *doc.Package
+	typesPkg  *types.Package
 	functions map[string]*doc.Func
 	constants map[string]*doc.Value
 	types     map[string]*doc.Type
@@ -266,16 +270,35 @@ func (pi *packageInfo) snipFile(
 	return decl, body, err //nolint:wrapcheck // Caller will wrap error.
 }
 
-func createPackageInfo(dir string) (*packageInfo, error) {
+func createPackageInfo(dir string, build Build) (*packageInfo, error) {
 	var (
 		docPkg        *doc.Package
 		packagesToDoc []*packages.Package
+		modDir        string
+		pattern       string
+		wsEnv         []string
 		err           error
 	)
 
-	szlog.Say1("Loading package info for: ", dir, "\n")
+	if desc := build.String(); desc != "" {
+		szlog.Say1("Loading package info for: ", dir, " (", desc, ")\n")
+	} else {
+		szlog.Say1("Loading package info for: ", dir, "\n")
+	}
+
+	modDir, pattern, err = gowork.Locate(dir)
+	if err == nil {
+		wsEnv, err = gowork.Env(dir)
+	}
+
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
 
 	cfg := new(packages.Config)
+	cfg.Dir = modDir
+	cfg.Env = append(append(os.Environ(), wsEnv...), build.Env()...)
+	cfg.BuildFlags = build.Flags()
 	cfg.Mode = packages.NeedName |
 		packages.NeedFiles |
 		packages.NeedCompiledGoFiles |
@@ -285,9 +308,10 @@ func createPackageInfo(dir string) (*packageInfo, error) {
 	cfg.Fset = token.NewFileSet()
 	cfg.Tests = false // Exclude test packages
 
-	packagesToDoc, err = packages.Load(cfg, dir)
+	packagesToDoc, err = proc.LoadPackages(cfg, pattern)
 
-	if err == nil && len(packagesToDoc[0].Errors) > 0 {
+	if err == nil &&
+		(len(packagesToDoc) == 0 || len(packagesToDoc[0].Errors) > 0) {
 		err = errs.ErrInvalidPackage
 	}
 
@@ -305,6 +329,7 @@ func createPackageInfo(dir string) (*packageInfo, error) {
 		return &packageInfo{
 			fSet:      packagesToDoc[0].Fset,
 			docPkg:    docPkg,
+			typesPkg:  packagesToDoc[0].Types,
 			functions: nil,
 			constants: nil,
 			types:     nil,
@@ -314,28 +339,52 @@ func createPackageInfo(dir string) (*packageInfo, error) {
 	return nil, err //nolint:wrapcheck // Caller will wrap error.
 }
 
-// Info returns documentation information for the named object.
+// Info returns documentation information for the named object using the
+// default build constraints.
 func Info(dir, name string) (*DocInfo, error) {
+	return InfoFor(dir, name, Build{})
+}
+
+// cachedPackageInfo returns the (possibly cached) package information for
+// the directory loaded with the supplied build constraints.
+func cachedPackageInfo(dir string, build Build) (*packageInfo, error) {
 	var (
 		pkgInfo *packageInfo
-		dInfo   *DocInfo
 		ok      bool
-		err     error
 	)
 
 	cwd, err := os.Getwd()
 	if err == nil {
-		pDir := filepath.Join(cwd, dir)
-		pkgInfo, ok = packageCache[pDir]
+		pKey := filepath.Join(cwd, dir) + "@" + build.key()
+		pkgInfo, ok = packageCache[pKey]
 
 		if !ok {
-			pkgInfo, err = createPackageInfo(dir)
+			pkgInfo, err = createPackageInfo(dir, build)
 			if err == nil {
-				packageCache[pDir] = pkgInfo
+				packageCache[pKey] = pkgInfo
 			}
 		}
 	}
 
+	if err == nil {
+		return pkgInfo, nil
+	}
+
+	return nil, err
+}
+
+// InfoFor returns documentation information for the named object loading
+// its package with the supplied build constraints.  Packages loaded with
+// different constraints are cached separately.
+func InfoFor(dir, name string, build Build) (*DocInfo, error) {
+	var (
+		pkgInfo *packageInfo
+		dInfo   *DocInfo
+		err     error
+	)
+
+	pkgInfo, err = cachedPackageInfo(dir, build)
+
 	if err == nil {
 		dInfo, err = pkgInfo.getInfo(name)
 	}
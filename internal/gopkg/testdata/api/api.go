@@ -0,0 +1,86 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package api exists in order to test the extraction of the exported API.
+package api
+
+import "io"
+
+// Limit is a typed constant.
+const Limit Size = 10
+
+// Greeting is an untyped constant.
+const Greeting = "hello"
+
+// Default is an exported variable.
+var Default = New("default") //nolint:gochecknoglobals // Ok.
+
+// Size is a defined type.
+type Size int
+
+// Alias is an alias.
+type Alias = Size
+
+// Widget is a structure.
+type Widget struct {
+	io.Reader
+
+	Name  string
+	Sizes []Size
+	count int
+}
+
+// Shape is an interface.
+type Shape interface {
+	io.Closer
+
+	Area() float64
+	Scale(factor float64, more ...float64) (Shape, error)
+}
+
+// New creates a widget.
+func New(name string) *Widget {
+	return &Widget{Reader: nil, Name: name, Sizes: nil, count: 0}
+}
+
+// Max returns the largest value.
+func Max[T int | float64](values ...T) T {
+	var res T
+
+	for _, v := range values {
+		res = max(res, v)
+	}
+
+	return res
+}
+
+// Count is a value method.
+func (w Widget) Count() int {
+	return w.count
+}
+
+// Rename is a pointer method.
+func (w *Widget) Rename(name string) {
+	w.Name = name
+}
+
+func (w *Widget) reset() {
+	w.count = 0
+}
+
+func helper() {}
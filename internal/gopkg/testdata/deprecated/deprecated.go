@@ -0,0 +1,64 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package deprecated exists in order to test the reporting of deprecated
+// identifiers.
+package deprecated
+
+// Limit is the current limit.
+const Limit = 10
+
+// OldLimit was the original limit.
+//
+// Deprecated: Use [Limit] instead.
+const OldLimit = 5
+
+// Widget is the current structure.
+type Widget struct{}
+
+// NewWidget creates a widget.
+func NewWidget() *Widget {
+	return &Widget{}
+}
+
+// MakeWidget creates a widget.
+//
+// Deprecated: Use NewWidget which returns a pointer.  This function
+// will be removed in the next major version.
+//
+// Additional details follow.
+func MakeWidget() Widget {
+	return Widget{}
+}
+
+// Size returns the size of the widget.
+func (Widget) Size() int {
+	return 0
+}
+
+// Len returns the size of the widget.
+//
+// Deprecated: Use Widget.Size instead.
+func (Widget) Len() int {
+	return 0
+}
+
+// Gone no longer does anything.
+//
+// Deprecated: Use strings.Clone | copy instead.
+func Gone() {}
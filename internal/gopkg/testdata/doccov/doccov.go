@@ -0,0 +1,62 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package doccov
+
+// Documented is a documented constant.
+const Documented = 1
+
+const Undocumented = 2
+
+// Values are described by their block comment.
+const (
+	ValueA = "A"
+	ValueB = "B"
+)
+
+// The wrong start for a variable comment.
+var Wrong = 3
+
+// A Widget may be preceded by an article.
+type Widget struct{}
+
+type Bare int
+
+// NewWidget constructs a widget.
+func NewWidget() *Widget {
+	return &Widget{}
+}
+
+// Documentation without the name.
+func Run() {}
+
+func Undocumented2() {}
+
+// Name is a documented method.
+func (Widget) Name() string {
+	return ""
+}
+
+func (Widget) Size() int {
+	return 0
+}
+
+// Method is a documented method on an undocumented type.
+func (Bare) Method() {}
+
+func unexported() {}
@@ -0,0 +1,145 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gorun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/proc"
+)
+
+type build struct {
+	bin string
+	err error
+}
+
+//nolint:goCheckNoGlobals // Ok.
+var (
+	buildDir   string
+	buildCache = make(map[string]*build)
+)
+
+// Reset removes all binaries built (and the temporary directory holding
+// them) so the next run builds its packages again.
+func Reset() {
+	if buildDir != "" {
+		_ = os.RemoveAll(buildDir)
+		buildDir = ""
+	}
+
+	for k := range buildCache {
+		delete(buildCache, k)
+	}
+}
+
+// builtBinary returns the path of the only file (the binary) written to
+// the directory by go build.
+func builtBinary(binDir string) (string, error) {
+	entries, err := os.ReadDir(binDir)
+	if err == nil && len(entries) != 1 {
+		err = fmt.Errorf("%w: no binary: %s", errs.ErrBuildFailed, binDir)
+	}
+
+	if err != nil {
+		return "", err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	return filepath.Join(binDir, entries[0].Name()), nil
+}
+
+// buildBinary builds the main package into its own temporary directory
+// (letting go name the binary as it would for go run or go install)
+// returning the absolute path of the binary.  Any failure (including the
+// compiler's output) is returned as an error.
+func buildBinary(
+	runDir, runPath, pkgPath string, env []string,
+) (string, error) {
+	var (
+		binDir string
+		bin    string
+		out    []byte
+		err    error
+	)
+
+	if buildDir == "" {
+		buildDir, err = os.MkdirTemp("", "gotomd-bin-")
+	}
+
+	if err == nil {
+		binDir, err = os.MkdirTemp(buildDir, "pkg-")
+	}
+
+	if err == nil {
+		c := proc.Command(
+			proc.Timeout(),
+			"go", "build", "-buildmode=exe",
+			"-o", binDir+string(os.PathSeparator), runPath,
+		)
+		c.Dir = runDir
+		c.Env = append(os.Environ(), env...)
+
+		out, err = c.CombinedOutput()
+		if err != nil && !proc.Stopped(err) {
+			err = fmt.Errorf("%w: %s\n%s",
+				errs.ErrBuildFailed,
+				cmds.QuoteWords([]string{"go", "build", pkgPath}),
+				strings.TrimRight(string(out), "\n"),
+			)
+		}
+	}
+
+	if err == nil {
+		bin, err = builtBinary(binDir)
+	}
+
+	if err == nil {
+		return bin, nil
+	}
+
+	return "", err
+}
+
+// binary returns the binary built from the main package building it on
+// first use.  Each package is built (successfully or not) only once until
+// Reset.
+func binary(runDir, runPath, pkgPath string, env []string) (string, error) {
+	absPath, err := filepath.Abs(filepath.Join(runDir, runPath))
+	if err != nil {
+		return "", err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	key := strings.Join(append([]string{absPath}, env...), "\n")
+
+	b, ok := buildCache[key]
+	if !ok {
+		b = new(build)
+		b.bin, b.err = buildBinary(runDir, runPath, pkgPath, env)
+
+		if !proc.Stopped(b.err) {
+			buildCache[key] = b
+		}
+	}
+
+	return b.bin, b.err
+}
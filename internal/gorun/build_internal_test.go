@@ -0,0 +1,57 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gorun
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Build_Once(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer Reset()
+
+	bin, err := binary("", "./testdata/tstpkg", "./testdata/tstpkg", nil)
+	chk.NoErr(err)
+
+	again, err := binary("", "./testdata/tstpkg", "./testdata/tstpkg", nil)
+	chk.NoErr(err)
+	chk.Str(again, bin)
+	chk.Int(len(buildCache), 1)
+
+	other, err := binary(
+		"", "./testdata/tstpkg", "./testdata/tstpkg", []string{"GOWORK=off"},
+	)
+	chk.NoErr(err)
+	chk.True(other != bin)
+	chk.Int(len(buildCache), 2)
+
+	dir := buildDir
+
+	Reset()
+
+	_, err = os.Stat(dir)
+	chk.True(os.IsNotExist(err))
+	chk.Str(buildDir, "")
+	chk.Int(len(buildCache), 0)
+}
@@ -20,6 +20,7 @@ package gorun
 
 import (
 	"bytes"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -28,6 +29,9 @@ import (
 	"github.com/dancsecs/gotomd/internal/cmds"
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
 )
 
 func joinKeepPrefix(dir, file string) string {
@@ -44,11 +48,39 @@ func joinKeepPrefix(dir, file string) string {
 	return joined
 }
 
-// RunGo executes the named go package in the provided directory.
-func RunGo(dir, cmd string) (string, string, error) {
+// relativeTo returns the path relative to the base directory keeping the
+// "./" prefix expected by go for local packages.
+func relativeTo(base, path string) (string, error) {
+	absBase, err := filepath.Abs(base)
+	if err == nil {
+		path, err = filepath.Abs(path)
+	}
+
+	if err == nil {
+		path, err = filepath.Rel(absBase, path)
+	}
+
+	if err != nil {
+		return "", err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	if filepath.IsLocal(path) {
+		path = "." + string(os.PathSeparator) + path
+	}
+
+	return path, nil
+}
+
+// Binary returns the absolute path of the binary built (once) from the
+// named go package in the provided directory.  Build failures are returned
+// as errors including the compiler's output.
+func Binary(dir, target string) (string, error) {
 	var (
-		rawRes []byte
-		args   []string
+		bin     string
+		pkgPath string
+		runDir  string
+		relDir  string
+		wsEnv   []string
 	)
 
 	stat, err := os.Stat(dir)
@@ -57,38 +89,107 @@ func RunGo(dir, cmd string) (string, string, error) {
 	}
 
 	if err == nil {
-		cmdArgs := strings.Split(cmd, " ")
-		args = append(
-			[]string{"run", joinKeepPrefix(dir, cmdArgs[0])},
-			cmdArgs[1:]...,
+		pkgPath = joinKeepPrefix(dir, target)
+
+		if _, statErr := os.Stat(pkgPath); statErr != nil {
+			err = errs.ErrNoPackageToRun
+		}
+	}
+
+	if err == nil {
+		runDir, relDir, wsEnv, err = gowork.Target(dir)
+	}
+
+	if err == nil {
+		bin, err = binary(
+			runDir, joinKeepPrefix(relDir, target), pkgPath, wsEnv,
 		)
 	}
 
 	if err == nil {
-		c := exec.Command("go", args...) //nolint:gosec // Ok.
-		//	c.Dir = dir
-		//  c.Env = setupEnv(os.Environ())
-		rawRes, _ = c.CombinedOutput() // We expect a general task error.
+		return bin, nil
+	}
 
-		if bytes.HasPrefix(
-			rawRes,
-			[]byte("package TEST_DOES_NOT_EXIST is not in"),
-		) {
-			err = errs.ErrNoPackageToRun
+	return "", err
+}
+
+// RunGo builds (once) and executes the named go package in the provided
+// directory passing it any additional arguments unchanged returning the
+// command and its output formatted as selected by the options.  Build
+// failures are returned as errors including the compiler's output and the
+// program must exit with the code expected by the options.  The command
+// returned is displayed as "go run" unless the options supply the program
+// name.  It is quoted (including any directory change, environment settings
+// and input redirection from the options) so that it may be copied and run
+// from a shell.  The program (and anything it starts) is killed if it runs
+// longer than the options' timeout.  Nil options run the package with the
+// defaults.
+func RunGo(
+	dir, target string, opts *cmds.RunOptions, cmdArgs ...string,
+) (string, string, error) {
+	var (
+		exitErr *exec.ExitError
+		code    int
+		lines   []cmds.Line
+		res     string
+		cmdLine string
+		bin     string
+		pkgPath string
+		err     error
+	)
+
+	if opts == nil {
+		_, opts, _ = cmds.ParseRunCmd("", proc.Timeout())
+	}
+
+	bin, err = Binary(dir, target)
+
+	if err == nil {
+		pkgPath = joinKeepPrefix(dir, target)
+	}
+
+	if err == nil && opts.Dir != "." {
+		// The program runs from the requested directory.
+		pkgPath, err = relativeTo(opts.Dir, pkgPath)
+	}
+
+	if err == nil {
+		c := proc.Command(opts.Timeout, bin, cmdArgs...)
+		c.Dir = opts.Dir
+		c.Env = append(os.Environ(), opts.Env...)
+		c.Stdin = bytes.NewReader(opts.Stdin)
+
+		lines, err = c.Lines()
+		if errors.As(err, &exitErr) {
+			code, err = exitErr.ExitCode(), nil
 		}
 	}
 
 	if err == nil {
-		return "go " + strings.Join(args, " "),
-			strings.TrimRight(string(rawRes), "\n"),
-			nil
+		lines, err = normalize.Lines(lines)
+	}
+
+	if err == nil {
+		cmdLine = opts.Command(
+			append(opts.Program("go", "run", pkgPath), cmdArgs...),
+		)
+		res, err = opts.Result(cmdLine, lines, code)
+	}
+
+	if err == nil {
+		return cmdLine, res, nil
 	}
 
 	return "", "", err
 }
 
+// goRun splits the command into shell-like words.  After any options the
+// first names the package to run while the rest are passed to it as
+// arguments.
 func goRun(cmd string) (string, string, error) {
 	var (
+		words  []string
+		opts   *cmds.RunOptions
 		dir    string
 		action string
 		runCmd string
@@ -96,9 +197,18 @@ func goRun(cmd string) (string, string, error) {
 		err    error
 	)
 
-	dir, action, err = cmds.ParseCmd(cmd)
+	words, opts, err = cmds.ParseRunCmd(cmd, proc.Timeout())
+
+	if err == nil {
+		if len(words) == 0 {
+			words = []string{""}
+		}
+
+		dir, action, err = cmds.ParseCmd(words[0])
+	}
+
 	if err == nil {
-		runCmd, runRes, err = RunGo(dir, action)
+		runCmd, runRes, err = RunGo(dir, action, opts, words[1:]...)
 	}
 
 	if err == nil {
@@ -108,7 +218,7 @@ func goRun(cmd string) (string, string, error) {
 	return "", "", err
 }
 
-// GetGoRun runs "go run" the provided package collecting and returning the
+// GetGoRun runs the provided package collecting and returning the
 // formatted output.
 func GetGoRun(cmd string) (string, error) {
 	runCmd, runRes, err := goRun(cmd)
@@ -117,7 +227,7 @@ func GetGoRun(cmd string) (string, error) {
 		return format.HLine() + "\n" +
 				format.Inline("bash", runCmd) + "\n" +
 				"\n" +
-				format.Inline("", runRes) + "\n" +
+				runRes + "\n" +
 				format.HLine(),
 			nil
 	}
@@ -125,13 +235,13 @@ func GetGoRun(cmd string) (string, error) {
 	return "", err
 }
 
-// RawGoRun runs "go run" the provided package collecting and returning the
+// RawGoRun runs the provided package collecting and returning the
 // raw output.
 func RawGoRun(cmd string) (string, error) {
 	_, runRes, err := goRun(cmd)
 
 	if err == nil {
-		return format.Inline("", runRes), nil
+		return runRes, nil
 	}
 
 	return "", err
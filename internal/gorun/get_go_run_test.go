@@ -54,7 +54,7 @@ func Test_GetRun_RunTestNotDirectory(t *testing.T) {
 
 	f := chk.CreateTmpFile(nil)
 
-	_, _, err := gorun.RunGo(f, "")
+	_, _, err := gorun.RunGo(f, "", nil)
 	chk.Err(
 		err,
 		errs.ErrInvalidDirectory.Error(),
@@ -65,8 +65,12 @@ func Test_GetRun_RunTestNoPackage(t *testing.T) {
 	chk := sztestlog.CaptureNothing(t)
 	defer chk.Release()
 
-	_, _, err := gorun.RunGo(".", "")
-	chk.NoErr(err)
+	_, _, err := gorun.RunGo(".", "", nil)
+	chk.Err(
+		err,
+		errs.ErrBuildFailed.Error()+": go build .\n"+
+			"-buildmode=exe requires exactly one main package",
+	)
 }
 
 func Test_GetRun_RunExampleNoPackage(t *testing.T) {
@@ -124,3 +128,386 @@ func Test_GetRun_RunExampleRaw(t *testing.T) {
 		"```\nRunning with 1 arguments\n-v\n```",
 	)
 }
+
+func Test_GetRun_RunWorkspaceModule(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.DelEnv("GOWORK")
+	chk.DelEnv("GOFLAGS") // Workspaces reject -mod=mod.
+
+	tstDir := chk.CreateTmpDir()
+	modA := chk.CreateTmpSubDir("modA")
+	modB := chk.CreateTmpSubDir("modB")
+
+	_ = chk.CreateTmpFileAs(tstDir, "go.work", []byte(
+		"go 1.25\n\nuse (\n\t./modA\n\t./modB\n)\n",
+	))
+	_ = chk.CreateTmpFileAs(modA, "go.mod", []byte("module a\n\ngo 1.25\n"))
+	_ = chk.CreateTmpFileAs(modB, "go.mod", []byte("module b\n\ngo 1.25\n"))
+	_ = chk.CreateTmpFileAs(modB, "main.go", []byte(""+
+		"package main\n"+
+		"\n"+
+		"import \"fmt\"\n"+
+		"\n"+
+		"func main() {\n"+
+		"\tfmt.Println(\"Hello from module b\")\n"+
+		"}\n",
+	))
+
+	t.Chdir(modA)
+
+	out, err := gorun.RawGoRun("../modB/.")
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		"```\nHello from module b\n```",
+	)
+}
+
+func Test_GetRun_RunQuotedArguments(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	out, err := gorun.GetGoRun(
+		"./testdata/tstpkg/main.go --name \"hello world\" '*.go' " +
+			"--path=./a/b 'two\nlines'",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"go run ./testdata/tstpkg/main.go --name 'hello world' "+
+			"'*.go' --path=./a/b 'two\nlines'\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"Running with 5 arguments\n"+
+			"--name\n"+
+			"hello world\n"+
+			"*.go\n"+
+			"--path=./a/b\n"+
+			"two\n"+
+			"lines\n"+
+			"```\n"+
+			"---"+
+			"",
+	)
+
+	_, err = gorun.GetGoRun("./testdata/tstpkg/main.go 'open")
+	chk.Err(
+		err,
+		errs.ErrUnterminatedQuote.Error()+
+			": ./testdata/tstpkg/main.go 'open",
+	)
+}
+
+func Test_GetRun_RunTimeoutOption(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	out, err := gorun.RawGoRun("timeout=5m ./testdata/tstpkg/main.go -v")
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		"```\nRunning with 1 arguments\n-v\n```",
+	)
+
+	// Options following the package are passed to it unchanged.
+	out, err = gorun.RawGoRun("./testdata/tstpkg/main.go timeout=1")
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		"```\nRunning with 1 arguments\ntimeout=1\n```",
+	)
+
+	_, err = gorun.RawGoRun("timeout=soon ./testdata/tstpkg/main.go")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: timeout="soon"`)
+
+	_, err = gorun.RawGoRun("timeout=1 timeout=2 ./testdata/tstpkg/main.go")
+	chk.Err(err, errs.ErrDuplicateOption.Error()+`: "timeout"`)
+}
+
+func Test_GetRun_RunInputOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	out, err := gorun.GetGoRun("./testdata/tstio/.")
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"go run ./testdata/tstio\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"Running in gorun\n"+
+			"GREETING=\n"+
+			"```\n"+
+			"---",
+	)
+
+	out, err = gorun.GetGoRun(
+		"env=GREETING='hello there' stdin=./testdata/tstio/input/lines.txt" +
+			" ./testdata/tstio/.",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"GREETING='hello there' go run ./testdata/tstio"+
+			" < testdata/tstio/input/lines.txt\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"Running in gorun\n"+
+			"GREETING=hello there\n"+
+			"stdin: first line\n"+
+			"stdin: second line\n"+
+			"```\n"+
+			"---",
+	)
+
+	out, err = gorun.GetGoRun(
+		"cwd=./testdata/tstio/input stdin=./testdata/tstio/input/lines.txt" +
+			" ./testdata/tstio/.",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"cd testdata/tstio/input && go run .. < lines.txt\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"Running in input\n"+
+			"GREETING=\n"+
+			"stdin: first line\n"+
+			"stdin: second line\n"+
+			"```\n"+
+			"---",
+	)
+
+	out, err = gorun.GetGoRun("" +
+		"stdin=<<EOF ./testdata/tstio/.\n" +
+		"  indented line\n" +
+		"last line\n" +
+		"EOF",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"go run ./testdata/tstio <<'EOF'\n"+
+			"  indented line\n"+
+			"last line\n"+
+			"EOF\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"Running in gorun\n"+
+			"GREETING=\n"+
+			"stdin:   indented line\n"+
+			"stdin: last line\n"+
+			"```\n"+
+			"---",
+	)
+}
+
+func Test_GetRun_RunInputOptionErrors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_, err := gorun.GetGoRun("stdin=./missing.txt ./testdata/tstio/.")
+	chk.Err(err, "open missing.txt: no such file or directory")
+
+	_, err = gorun.GetGoRun("stdin=../input.txt ./testdata/tstio/.")
+	chk.Err(err, errs.ErrNotLocalDir.Error()+`: "../input.txt"`)
+
+	_, err = gorun.GetGoRun("cwd=./missing ./testdata/tstio/.")
+	chk.Err(err, errs.ErrInvalidDirectory.Error()+`: "missing"`)
+
+	_, err = gorun.GetGoRun("stdin=<<EOF ./testdata/tstio/.\nline")
+	chk.Err(err, errs.ErrUnterminatedHeredoc.Error()+": <<EOF")
+}
+
+func Test_GetRun_RunExitCode(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_, err := gorun.GetGoRun("./testdata/tstexit/. 2")
+	chk.Err(
+		err,
+		errs.ErrUnexpectedExit.Error()+
+			": 2 (expected 0): go run ./testdata/tstexit 2\n"+
+			"exiting with 2",
+	)
+
+	out, err := gorun.GetGoRun("expect-exit=2 ./testdata/tstexit/. 2")
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"go run ./testdata/tstexit 2\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"exiting with 2\n"+
+			"$ echo $?\n"+
+			"2\n"+
+			"```\n"+
+			"---",
+	)
+
+	out, err = gorun.RawGoRun(
+		"expect-exit=3 show-exit=false ./testdata/tstexit/. 3",
+	)
+	chk.NoErr(err)
+	chk.Str(out, "```\nexiting with 3\n```")
+
+	out, err = gorun.RawGoRun("show-exit=true ./testdata/tstexit/. 0")
+	chk.NoErr(err)
+	chk.Str(out, "```\nexiting with 0\n$ echo $?\n0\n```")
+
+	_, err = gorun.RawGoRun("expect-exit=1 ./testdata/tstexit/. 0")
+	chk.Err(
+		err,
+		errs.ErrUnexpectedExit.Error()+
+			": 0 (expected 1): go run ./testdata/tstexit 0\n"+
+			"exiting with 0",
+	)
+
+	_, err = gorun.RawGoRun("expect-exit=256 ./testdata/tstexit/. 0")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: expect-exit="256"`)
+}
+
+func Test_GetRun_RunOutputStreams(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	const pkg = " ./testdata/tststreams/."
+
+	out, err := gorun.RawGoRun("expect-exit=1 show-exit=false" + pkg)
+	chk.NoErr(err)
+	chk.Str(out, "```\nresult: 42\nusage: tststreams [-v]\ndone\n```")
+
+	out, err = gorun.RawGoRun("expect-exit=1 output=stdout" + pkg)
+	chk.NoErr(err)
+	chk.Str(out, "```\nresult: 42\ndone\n$ echo $?\n1\n```")
+
+	out, err = gorun.RawGoRun(
+		"expect-exit=1 show-exit=false output=stderr" + pkg,
+	)
+	chk.NoErr(err)
+	chk.Str(out, "```\nusage: tststreams [-v]\n```")
+
+	out, err = gorun.RawGoRun(
+		"expect-exit=1 show-exit=false output=marked" + pkg,
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		"```\nresult: 42\n[stderr] usage: tststreams [-v]\ndone\n```",
+	)
+
+	out, err = gorun.GetGoRun(
+		"expect-exit=1 show-exit=false output=both" + pkg,
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"go run ./testdata/tststreams\n"+
+			"```\n"+
+			"\n"+
+			"stdout:\n"+
+			"\n"+
+			"```\n"+
+			"result: 42\n"+
+			"done\n"+
+			"```\n"+
+			"\n"+
+			"stderr:\n"+
+			"\n"+
+			"```\n"+
+			"usage: tststreams [-v]\n"+
+			"```\n"+
+			"---",
+	)
+}
+
+func Test_GetRun_RunSelection(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer gorun.Reset()
+
+	const pkg = " ./testdata/tststreams/."
+
+	out, err := gorun.RawGoRun("expect-exit=1 tail=1" + pkg)
+	chk.NoErr(err)
+	chk.Str(out, "```\n...\ndone\n$ echo $?\n1\n```")
+
+	out, err = gorun.RawGoRun(
+		"expect-exit=1 show-exit=false output=stdout grep=result" + pkg,
+	)
+	chk.NoErr(err)
+	chk.Str(out, "```\nresult: 42\n...\n```")
+
+	_, err = gorun.RawGoRun("lines=3-1" + pkg)
+	chk.Err(err, errs.ErrInvalidRange.Error()+`: lines="3-1"`)
+}
+
+func Test_GetRun_RunBuildFailure(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer gorun.Reset()
+
+	_, err := gorun.GetGoRun("./testdata/tstbroken/. -v")
+	chk.Err(
+		err,
+		errs.ErrBuildFailed.Error()+": go build ./testdata/tstbroken\n"+
+			"# github.com/dancsecs/gotomd/internal/gorun/testdata/tstbroken\n"+
+			"testdata/tstbroken/main.go:10:14: undefined: notDefined",
+	)
+}
+
+func Test_GetRun_RunAs(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer gorun.Reset()
+
+	out, err := gorun.GetGoRun("as=tstpkg ./testdata/tstpkg/main.go -v")
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"---\n"+
+			"```bash\n"+
+			"tstpkg -v\n"+
+			"```\n"+
+			"\n"+
+			"```\n"+
+			"Running with 1 arguments\n"+
+			"-v\n"+
+			"```\n"+
+			"---"+
+			"",
+	)
+}
@@ -0,0 +1,11 @@
+// Provides a simple example that fails to compile.
+package main
+
+import (
+	"fmt"
+)
+
+//nolint:forbidigo // Printing is ok.
+func main() {
+	fmt.Println(notDefined)
+}
@@ -0,0 +1,17 @@
+// Provides a simple example to test go run exit codes.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+//nolint:forbidigo // Printing is ok.
+func main() {
+	code, _ := strconv.Atoi(os.Args[1])
+
+	fmt.Printf("exiting with %d\n", code)
+
+	os.Exit(code)
+}
@@ -0,0 +1,22 @@
+// Provides a simple example to test go run input options.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+//nolint:forbidigo // Printing is ok.
+func main() {
+	wd, _ := os.Getwd()
+
+	fmt.Printf("Running in %s\n", filepath.Base(wd))
+	fmt.Printf("GREETING=%s\n", os.Getenv("GREETING"))
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fmt.Printf("stdin: %s\n", scanner.Text())
+	}
+}
@@ -0,0 +1,21 @@
+// Provides a simple example to test go run output streams.
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Separate streams are read independently so pause to keep their order.
+const pause = 50 * time.Millisecond
+
+//nolint:forbidigo // Printing is ok.
+func main() {
+	fmt.Println("result: 42")
+	time.Sleep(pause)
+	fmt.Fprintln(os.Stderr, "usage: tststreams [-v]")
+	time.Sleep(pause)
+	fmt.Println("done")
+	os.Exit(1)
+}
@@ -21,14 +21,17 @@ package gotest
 import (
 	"bytes"
 	"os"
-	"os/exec"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dancsecs/gotomd/internal/ansi"
 	"github.com/dancsecs/gotomd/internal/cmds"
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
 )
 
 //nolint:goCheckNoGlobals // Ok.
@@ -82,10 +85,61 @@ func setupEnv(env []string) []string {
 	return append(newEnv, szEnv...)
 }
 
-func runTest(dir, tests string, colorize bool) (string, string, error) {
+// Options accepted by the tst and tstc directives (along with the
+// selection options).
+const optTimeout = "timeout"
+
+// testOptions holds the options supplied to the tst and tstc directives.
+type testOptions struct {
+	timeout time.Duration
+	sel     *cmds.Selection
+	reports []string
+}
+
+// parseOptions removes the options from the command returning the remaining
+// command and the options.
+func parseOptions(cmd string) (string, *testOptions, error) {
+	var (
+		opts    cmds.Options
+		tstOpts = new(testOptions)
+	)
+
+	cmd, opts, err := cmds.ExtractOptions(
+		cmd, append([]string{optTimeout, optReport}, cmds.SelectionKeys...)...,
+	)
+
+	if err == nil {
+		tstOpts.timeout, err = opts.Duration(optTimeout, proc.Timeout())
+	}
+
+	if err == nil {
+		tstOpts.sel, err = cmds.NewSelection(opts)
+	}
+
+	if err == nil && opts.Has(optReport) {
+		tstOpts.reports, err = parseReports(opts.Value(optReport, ""))
+	}
+
+	if err == nil {
+		return cmd, tstOpts, nil
+	}
+
+	return "", nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+func runTest(
+	dir, tests string, colorize bool, opts *testOptions,
+) (string, string, error) {
+	if opts.reports != nil {
+		return runReport(dir, tests, opts.timeout, opts.reports, opts.sel)
+	}
+
 	var (
 		rawRes  []byte
 		tstArgs []string
+		runDir  string
+		relDir  string
+		wsEnv   []string
 		res     string
 	)
 
@@ -94,6 +148,10 @@ func runTest(dir, tests string, colorize bool) (string, string, error) {
 		err = errs.ErrInvalidDirectory
 	}
 
+	if err == nil {
+		runDir, relDir, wsEnv, err = gowork.Target(dir)
+	}
+
 	if err == nil {
 		tstArgs = []string{"test", "-v", "-cover"}
 
@@ -101,12 +159,18 @@ func runTest(dir, tests string, colorize bool) (string, string, error) {
 			tstArgs = append(tstArgs, "-run", tests)
 		}
 
+		c := proc.Command(opts.timeout, "go", append(tstArgs, relDir)...)
+		c.Dir = runDir
+		c.Env = append(setupEnv(os.Environ()), wsEnv...)
 		tstArgs = append(tstArgs, dir)
-		c := exec.Command("go", tstArgs...) //nolint:gosec // Ok.
-		//	c.Dir = dir
-		c.Env = setupEnv(os.Environ())
-		rawRes, _ = c.CombinedOutput() // We expect a general task error.
 
+		rawRes, err = c.CombinedOutput()
+		if !proc.Stopped(err) {
+			err = nil // We expect a general task error.
+		}
+	}
+
+	if err == nil {
 		if bytes.HasPrefix(
 			rawRes,
 			[]byte("testing: warning: no tests to run"),
@@ -115,19 +179,21 @@ func runTest(dir, tests string, colorize bool) (string, string, error) {
 		}
 	}
 
+	if err == nil {
+		res, err = normalize.String(string(rawRes))
+		res = opts.sel.Apply(res)
+	}
+
 	if err == nil {
 		if colorize {
-			res = squashTestTime.ReplaceAllString(
-				string(rawRes), `${1} (0.0s)`,
-			)
+			res = squashTestTime.ReplaceAllString(res, `${1} (0.0s)`)
 			res = squashAllTestTime.ReplaceAllString(res, `FAIL ${1} 0.0s`)
 			res = squashCached.ReplaceAllString(res, `${1}${2}`)
 			res = strings.ReplaceAll(res, " PASS: ", " PASS:  ")
 			res = strings.ReplaceAll(res, " FAIL: ", " FAIL:  ")
 			res = ansi.Colorize(res, true)
 		} else {
-			res = "<pre>\n" + strings.TrimRight(string(rawRes), "\n") +
-				"\n</pre>"
+			res = "<pre>\n" + strings.TrimRight(res, "\n") + "\n</pre>"
 			res = squashTestTime.ReplaceAllString(res, `${1} (0.0s)`)
 			res = squashAllTestTime.ReplaceAllString(res, `FAIL ${1} 0.0s`)
 			res = squashCached.ReplaceAllString(res, `${1}${2}`)
@@ -184,15 +250,23 @@ func GetGoTst(cmd string) (string, error) {
 		res    string
 		tstRes string
 		tstCmd string
+		opts   *testOptions
+		dir    []string
+		action []string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	cmd, opts, err := parseOptions(cmd)
+
+	if err == nil {
+		dir, action, err = cmds.ParseCmds(cmd)
+	}
+
 	if err == nil {
 		dir, action = buildTestCmds(dir, action)
 	}
 
 	for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-		tstCmd, tstRes, err = runTest(dir[i], action[i], false)
+		tstCmd, tstRes, err = runTest(dir[i], action[i], false, opts)
 		if err == nil {
 			if res != "" {
 				res += "\n\n"
@@ -218,15 +292,23 @@ func GetGoTstColorize(cmd string) (string, error) {
 		res    string
 		tstRes string
 		tstCmd string
+		opts   *testOptions
+		dir    []string
+		action []string
 	)
 
-	dir, action, err := cmds.ParseCmds(cmd)
+	cmd, opts, err := parseOptions(cmd)
+
+	if err == nil {
+		dir, action, err = cmds.ParseCmds(cmd)
+	}
+
 	if err == nil {
 		dir, action = buildTestCmds(dir, action)
 	}
 
 	for i, mi := 0, len(dir); i < mi && err == nil; i++ {
-		tstCmd, tstRes, err = runTest(dir[i], action[i], true)
+		tstCmd, tstRes, err = runTest(dir[i], action[i], true, opts)
 		if err == nil {
 			if res != "" {
 				res += "\n\n"
@@ -22,11 +22,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/dancsecs/gotomd/internal/ansi"
 	"github.com/dancsecs/gotomd/internal/args"
 	"github.com/dancsecs/gotomd/internal/errs"
 	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/proc"
 	"github.com/dancsecs/sztestlog"
 )
 
@@ -78,12 +80,42 @@ func Test_GetTest_RunTestNotDirectory(t *testing.T) {
 	f := chk.CreateTmpFile(nil)
 	chk.Panic(
 		func() {
-			_, _, _ = runTest(f, "", false)
+			_, _, _ = runTest(f, "", false, &testOptions{
+				timeout: proc.DefaultTimeout, sel: nil, reports: nil,
+			})
 		},
 		"",
 	)
 }
 
+func Test_GetTest_ParseOptions(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	cmd, opts, err := parseOptions("./pkg timeout=5 head=2 tail=1")
+	chk.NoErr(err)
+	chk.Str(cmd, "./pkg")
+	chk.Dur(opts.timeout, 5*time.Second)
+	chk.Str(opts.sel.Apply("1\n2\n3\n4\n5\n"), "1\n2\n...\n5\n")
+	chk.StrSlice(opts.reports, nil)
+
+	cmd, opts, err = parseOptions("./pkg report=summary,table")
+	chk.NoErr(err)
+	chk.Str(cmd, "./pkg")
+	chk.Dur(opts.timeout, proc.Timeout())
+	chk.True(opts.sel == nil)
+	chk.StrSlice(opts.reports, []string{"summary", "table"})
+
+	_, _, err = parseOptions("./pkg grep=(")
+	chk.Err(err, ""+
+		errs.ErrInvalidOption.Error()+`: grep="(": `+
+		"error parsing regexp: missing closing ): `(`",
+	)
+
+	_, _, err = parseOptions("./pkg report=table,chart")
+	chk.Err(err, errs.ErrInvalidOption.Error()+`: report="table,chart"`)
+}
+
 //nolint:funlen // Ok.
 func Test_GetTest_RunTestColorize(t *testing.T) {
 	chk := sztestlog.CaptureStdout(t)
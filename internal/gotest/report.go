@@ -0,0 +1,521 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
+)
+
+// Renderings selected by the report option.
+const (
+	optReport = "report"
+
+	reportTable   = "table"
+	reportDetails = "details"
+	reportSummary = "summary"
+)
+
+//nolint:goCheckNoGlobals // Ok.
+var reportKinds = []string{reportTable, reportDetails, reportSummary}
+
+// Test statuses reported by go test -json.
+const (
+	statusPass = "pass"
+	statusFail = "fail"
+	statusSkip = "skip"
+)
+
+// Output framing the tests rather than produced by them.
+// "=== RUN   Test_Double", "    --- PASS: Test_Double/one (0.01s)".
+var frameLine = regexp.MustCompile(
+	`^(?:=== (?:RUN|PAUSE|CONT|NAME)\b|\s*--- (?:PASS|FAIL|SKIP): )`,
+)
+
+// "\x1b[35m".
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*[A-Za-z]")
+
+// testEvent is a single event emitted by go test -json.
+type testEvent struct {
+	Action     string `json:"Action"`
+	Package    string `json:"Package"`
+	ImportPath string `json:"ImportPath"`
+	Test       string `json:"Test"`
+	Output     string `json:"Output"`
+}
+
+// pkgName returns the package the event belongs to.  Build output is
+// identified by the import path being built which, for a test variant
+// ("pkg_test [pkg.test]"), names the package under test in brackets.
+func (e testEvent) pkgName() string {
+	if e.Package != "" {
+		return e.Package
+	}
+
+	name, variant, found := strings.Cut(e.ImportPath, " [")
+	if found {
+		return strings.TrimSuffix(strings.TrimSuffix(variant, "]"), ".test")
+	}
+
+	return name
+}
+
+// testResult collects the events of a single test (or subtest).
+type testResult struct {
+	name     string
+	status   string
+	output   []string
+	subtests []*testResult
+}
+
+// testReport collects the results of all the tests in a package in the
+// order they were started.  Results and output are grouped by test so the
+// report does not depend on timing or the interleaving of parallel tests.
+type testReport struct {
+	tests   []*testResult
+	byName  map[string]*testResult
+	status  string
+	output  []string
+	failed  int
+	passed  int
+	skipped int
+}
+
+func (r *testReport) result(name string) *testResult {
+	res, ok := r.byName[name]
+	if !ok {
+		res = &testResult{name: name, status: "", output: nil, subtests: nil}
+		r.byName[name] = res
+
+		parent, found := r.byName[name[:max(strings.LastIndex(name, "/"), 0)]]
+		if found {
+			parent.subtests = append(parent.subtests, res)
+		} else {
+			r.tests = append(r.tests, res)
+		}
+	}
+
+	return res
+}
+
+func (r *testReport) add(event testEvent) {
+	if event.Test == "" {
+		switch event.Action {
+		case statusPass, statusFail, statusSkip:
+			r.status = event.Action
+		case "output", "build-output":
+			r.output = append(r.output, event.Output)
+		}
+
+		return
+	}
+
+	res := r.result(event.Test)
+
+	switch event.Action {
+	case statusPass, statusFail, statusSkip:
+		res.status = event.Action
+	case "output":
+		if !frameLine.MatchString(event.Output) {
+			res.output = append(res.output, event.Output)
+		}
+	}
+}
+
+// parseReport parses the go test -json output.  Lines that are not events
+// (such as build errors) are kept as package output.
+func parseReport(raw string) *testReport {
+	r := &testReport{
+		tests:   nil,
+		byName:  make(map[string]*testResult),
+		status:  "",
+		output:  nil,
+		failed:  0,
+		passed:  0,
+		skipped: 0,
+	}
+
+	for _, line := range strings.Split(raw, "\n") {
+		var event testEvent
+
+		if strings.HasPrefix(line, "{") &&
+			json.Unmarshal([]byte(line), &event) == nil {
+			r.add(event)
+		} else if line != "" {
+			r.output = append(r.output, line+"\n")
+		}
+	}
+
+	r.count(r.tests)
+
+	return r
+}
+
+func (r *testReport) count(tests []*testResult) {
+	for _, t := range tests {
+		switch t.status {
+		case statusPass:
+			r.passed++
+		case statusSkip:
+			r.skipped++
+		default:
+			r.failed++
+		}
+
+		r.count(t.subtests)
+	}
+}
+
+// counts describes the number of tests passed, failed and skipped omitting
+// any that are zero.
+func counts(passed, failed, skipped int) string {
+	var parts []string
+
+	for _, c := range []struct {
+		n     int
+		label string
+	}{
+		{passed, "passed"}, {failed, "failed"}, {skipped, "skipped"},
+	} {
+		if c.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", c.n, c.label))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func statusLabel(status string) string {
+	if status == "" {
+		return "FAIL"
+	}
+
+	return strings.ToUpper(status)
+}
+
+func (r *testReport) table() string {
+	rows := []string{
+		"| Test | Status | Subtests |",
+		"| ---- | ------ | -------- |",
+	}
+
+	for _, t := range r.tests {
+		sub := new(testReport)
+		sub.count(t.subtests)
+
+		rows = append(rows, fmt.Sprintf("| %s | %s | %s |",
+			strings.ReplaceAll(t.name, "|", `\|`),
+			statusLabel(t.status),
+			counts(sub.passed, sub.failed, sub.skipped),
+		))
+	}
+
+	table := strings.Join(rows, "\n")
+
+	if format.IsForMarkdown() {
+		return table
+	}
+
+	return format.Inline("", table)
+}
+
+// testOutput returns the test's output without escape sequences and with
+// the common indentation removed.
+func testOutput(output []string) string {
+	lines := strings.Split(
+		strings.TrimRight(
+			ansiEscape.ReplaceAllString(strings.Join(output, ""), ""), "\n",
+		),
+		"\n",
+	)
+
+	indent := -1
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			n := len(line) - len(strings.TrimLeft(line, " \t"))
+			if indent < 0 || n < indent {
+				indent = n
+			}
+		}
+	}
+
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// failures returns the failed tests without failed subtests of their own.
+func failures(tests []*testResult) []*testResult {
+	var failed []*testResult
+
+	for _, t := range tests {
+		if t.status == statusPass || t.status == statusSkip {
+			continue
+		}
+
+		sub := failures(t.subtests)
+		if len(sub) == 0 {
+			failed = append(failed, t)
+		}
+
+		failed = append(failed, sub...)
+	}
+
+	return failed
+}
+
+func (r *testReport) details(sel *cmds.Selection) (string, error) {
+	var (
+		blocks []string
+		out    string
+		err    error
+	)
+
+	for _, t := range failures(r.tests) {
+		out, err = normalize.String(testOutput(t.output))
+		if err != nil {
+			return "", err //nolint:wrapcheck // Caller will wrap error.
+		}
+
+		out = format.Inline("", sel.Apply(out))
+
+		if format.IsForMarkdown() {
+			blocks = append(blocks, ""+
+				"<details>\n"+
+				"<summary>FAIL: "+t.name+"</summary>\n"+
+				"\n"+
+				out+"\n"+
+				"\n"+
+				"</details>",
+			)
+		} else {
+			blocks = append(blocks,
+				strings.TrimRight("FAIL: "+t.name+"\n\n"+out, "\n"),
+			)
+		}
+	}
+
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+func (r *testReport) summary() string {
+	return statusLabel(r.status) + ": " +
+		counts(r.passed, r.failed, r.skipped)
+}
+
+// render returns the requested renderings of the report in order.
+func (r *testReport) render(
+	reports []string, sel *cmds.Selection,
+) (string, error) {
+	var (
+		res   []string
+		block string
+		err   error
+	)
+
+	for i, mi := 0, len(reports); i < mi && err == nil; i++ {
+		switch reports[i] {
+		case reportTable:
+			block = r.table()
+		case reportDetails:
+			block, err = r.details(sel)
+		default:
+			block = r.summary()
+		}
+
+		if block != "" {
+			res = append(res, block)
+		}
+	}
+
+	return strings.Join(res, "\n\n"), err
+}
+
+// parseReports validates the comma separated list of renderings.
+func parseReports(value string) ([]string, error) {
+	reports := strings.Split(value, ",")
+
+	for _, report := range reports {
+		if !slices.Contains(reportKinds, report) {
+			return nil, fmt.Errorf(
+				"%w: %s=%q", errs.ErrInvalidOption, optReport, value,
+			)
+		}
+	}
+
+	return reports, nil
+}
+
+// runReport runs the tests with go test -json returning the command and the
+// requested renderings of the results.  A package failing without running
+// any tests (failing to build) is returned as an error.
+func runReport(
+	dir, tests string, timeout time.Duration,
+	reports []string, sel *cmds.Selection,
+) (string, string, error) {
+	var (
+		rawRes  []byte
+		tstArgs []string
+		runDir  string
+		relDir  string
+		wsEnv   []string
+		report  *testReport
+		res     string
+	)
+
+	stat, err := os.Stat(dir)
+	if err == nil && !stat.IsDir() {
+		err = errs.ErrInvalidDirectory
+	}
+
+	if err == nil {
+		runDir, relDir, wsEnv, err = gowork.Target(dir)
+	}
+
+	if err == nil {
+		tstArgs = []string{"test", "-json"}
+
+		if tests != "package" {
+			tstArgs = append(tstArgs, "-run", tests)
+		}
+
+		c := proc.Command(timeout, "go", append(tstArgs, relDir)...)
+		c.Dir = runDir
+		c.Env = append(os.Environ(), wsEnv...)
+		tstArgs = append(tstArgs, dir)
+
+		rawRes, err = c.CombinedOutput()
+		if !proc.Stopped(err) {
+			err = nil // Failing tests are reported.
+		}
+	}
+
+	if err == nil {
+		report = parseReport(string(rawRes))
+
+		switch {
+		case len(report.tests) == 0 && report.status == statusFail:
+			err = fmt.Errorf("%w: go %s\n%s",
+				errs.ErrBuildFailed, strings.Join(tstArgs, " "),
+				strings.TrimRight(strings.Join(report.output, ""), "\n"),
+			)
+		case len(report.tests) == 0:
+			err = errs.ErrNoTestToRun
+		}
+	}
+
+	if err == nil {
+		res, err = report.render(reports, sel)
+	}
+
+	if err == nil {
+		return "go " + strings.Join(tstArgs, " "), res, nil
+	}
+
+	return "", "", err
+}
+
+// Count runs the tests of the package in the directory (action ".") or of
+// every package below it (action "...") returning the number of tests
+// (including subtests) passed, failed and skipped.  A package failing
+// without running any tests (failing to build) is returned as an error.
+func Count(
+	dir, action string, timeout time.Duration,
+) (int, int, int, error) {
+	var (
+		rawRes   []byte
+		runDir   string
+		relDir   string
+		wsEnv    []string
+		pkgNames []string
+		pkgLines = make(map[string][]string)
+		total    = new(testReport)
+	)
+
+	runDir, relDir, wsEnv, err := gowork.Target(dir)
+
+	if err == nil {
+		pattern := relDir
+		if action == "..." {
+			pattern = relDir + string(os.PathSeparator) + action
+		}
+
+		c := proc.Command(timeout, "go", "test", "-json", pattern)
+		c.Dir = runDir
+		c.Env = append(os.Environ(), wsEnv...)
+
+		rawRes, err = c.CombinedOutput()
+		if !proc.Stopped(err) {
+			err = nil // Failing tests are counted.
+		}
+	}
+
+	if err == nil {
+		for _, line := range strings.Split(string(rawRes), "\n") {
+			var event testEvent
+
+			if json.Unmarshal([]byte(line), &event) == nil {
+				name := event.pkgName()
+				if _, ok := pkgLines[name]; !ok {
+					pkgNames = append(pkgNames, name)
+				}
+
+				pkgLines[name] = append(pkgLines[name], line)
+			}
+		}
+	}
+
+	for i, mi := 0, len(pkgNames); i < mi && err == nil; i++ {
+		report := parseReport(strings.Join(pkgLines[pkgNames[i]], "\n"))
+
+		if len(report.tests) == 0 && report.status == statusFail {
+			err = fmt.Errorf("%w: go test -json %s\n%s",
+				errs.ErrBuildFailed, pkgNames[i],
+				strings.TrimRight(strings.Join(report.output, ""), "\n"),
+			)
+		}
+
+		total.passed += report.passed
+		total.failed += report.failed
+		total.skipped += report.skipped
+	}
+
+	if err == nil {
+		return total.passed, total.failed, total.skipped, nil
+	}
+
+	return 0, 0, 0, err //nolint:wrapcheck // Caller will wrap error.
+}
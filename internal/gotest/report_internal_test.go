@@ -0,0 +1,217 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gotest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/sztestlog"
+)
+
+const tstpkg3Path = "." + sep + "testdata" + sep + "tstpkg3"
+
+// Parallel subtests interleaving their output and finishing out of order.
+//
+//nolint:goCheckNoGlobals // Ok.
+var interleavedEvents = strings.Join([]string{
+	`{"Action":"start"}`,
+	`{"Action":"run","Test":"Test_A"}`,
+	`{"Action":"output","Test":"Test_A","Output":"=== RUN   Test_A\n"}`,
+	`{"Action":"run","Test":"Test_A/x|y"}`,
+	`{"Action":"run","Test":"Test_A/two"}`,
+	`{"Action":"output","Test":"Test_A/two",` +
+		`"Output":"    a_test.go:9: \u001b[35mtwo\u001b[0m 1\n"}`,
+	`{"Action":"output","Test":"Test_A/x|y",` +
+		`"Output":"    a_test.go:5: one\n"}`,
+	`{"Action":"output","Test":"Test_A/two","Output":"        detail\n"}`,
+	`{"Action":"fail","Test":"Test_A/two","Elapsed":0.02}`,
+	`{"Action":"output","Test":"Test_A/x|y",` +
+		`"Output":"    --- PASS: Test_A/x|y (0.01s)\n"}`,
+	`{"Action":"pass","Test":"Test_A/x|y","Elapsed":0.01}`,
+	`{"Action":"fail","Test":"Test_A","Elapsed":0.03}`,
+	`{"Action":"run","Test":"Test_B"}`,
+	`{"Action":"output","Test":"Test_B",` +
+		`"Output":"    b_test.go:3: took 1.25s\n"}`,
+	`{"Action":"fail","Test":"Test_B","Elapsed":0}`,
+	`{"Action":"run","Test":"Test_C"}`,
+	`{"Action":"skip","Test":"Test_C","Elapsed":0}`,
+	`not an event`,
+	`{"Action":"fail","Elapsed":0.04}`,
+}, "\n")
+
+func Test_Report_Render(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	r := parseReport(interleavedEvents)
+
+	out, err := r.render(reportKinds, nil)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		"| Test | Status | Subtests |\n"+
+		"| ---- | ------ | -------- |\n"+
+		"| Test_A | FAIL | 1 passed, 1 failed |\n"+
+		"| Test_B | FAIL |  |\n"+
+		"| Test_C | SKIP |  |\n"+
+		"\n"+
+		"<details>\n"+
+		"<summary>FAIL: Test_A/two</summary>\n"+
+		"\n"+
+		"```\n"+
+		"a_test.go:9: two 1\n"+
+		"    detail\n"+
+		"```\n"+
+		"\n"+
+		"</details>\n"+
+		"\n"+
+		"<details>\n"+
+		"<summary>FAIL: Test_B</summary>\n"+
+		"\n"+
+		"```\n"+
+		"b_test.go:3: took 0.0s\n"+
+		"```\n"+
+		"\n"+
+		"</details>\n"+
+		"\n"+
+		"FAIL: 1 passed, 3 failed, 1 skipped",
+	)
+	chk.Str(strings.Join(r.output, ""), "not an event\n")
+
+	format.ForGoDoc()
+	defer format.ForMarkdown()
+
+	out, err = r.render([]string{reportDetails, reportTable}, nil)
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		"FAIL: Test_A/two\n"+
+		"\n"+
+		"\ta_test.go:9: two 1\n"+
+		"\t    detail\n"+
+		"\n"+
+		"FAIL: Test_B\n"+
+		"\n"+
+		"\tb_test.go:3: took 0.0s\n"+
+		"\n"+
+		"\t| Test | Status | Subtests |\n"+
+		"\t| ---- | ------ | -------- |\n"+
+		"\t| Test_A | FAIL | 1 passed, 1 failed |\n"+
+		"\t| Test_B | FAIL |  |\n"+
+		"\t| Test_C | SKIP |  |",
+	)
+}
+
+func Test_Report_Run(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	cmd, out, err := runReport(
+		tstpkg3Path, pkgLabel, proc.DefaultTimeout, reportKinds, nil,
+	)
+	chk.NoErr(err)
+	chk.Str(cmd, "go test -json "+tstpkg3Path)
+	chk.Str(out, ""+
+		"| Test | Status | Subtests |\n"+
+		"| ---- | ------ | -------- |\n"+
+		"| Test_Double | FAIL | 2 passed, 1 failed |\n"+
+		"| Test_Parallel | PASS |  |\n"+
+		"| Test_Skipped | SKIP |  |\n"+
+		"| Test_Simple | PASS |  |\n"+
+		"\n"+
+		"<details>\n"+
+		"<summary>FAIL: Test_Double/wrong</summary>\n"+
+		"\n"+
+		"```\n"+
+		"example3_test.go:26: Double(2) = 4, want 5\n"+
+		"```\n"+
+		"\n"+
+		"</details>\n"+
+		"\n"+
+		"FAIL: 4 passed, 2 failed, 1 skipped",
+	)
+
+	out, err = GetGoTst(tstpkg3Path + sep + "Test_Simple report=summary")
+	chk.NoErr(err)
+	chk.Str(out, ""+
+		"```bash\n"+
+		"go test -json -run Test_Simple "+tstpkg3Path+"\n"+
+		"```\n"+
+		"\n"+
+		"PASS: 1 passed",
+	)
+}
+
+func Test_Report_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	const brokenPath = "." + sep + "testdata" + sep + "tstbroken"
+
+	_, _, err := runReport(
+		brokenPath, pkgLabel, proc.DefaultTimeout, reportKinds, nil,
+	)
+	chk.Err(err, ""+
+		errs.ErrBuildFailed.Error()+": go test -json "+brokenPath+"\n"+
+		"# github.com/dancsecs/gotomd/internal/gotest/testdata/"+
+		"tstbroken_test [github.com/dancsecs/gotomd/internal/gotest/"+
+		"testdata/tstbroken.test]\n"+
+		"testdata/tstbroken/broken_test.go:6:8: undefined: notDefined\n"+
+		"FAIL\tgithub.com/dancsecs/gotomd/internal/gotest/testdata/"+
+		"tstbroken [build failed]",
+	)
+
+	_, _, err = runReport(
+		tstpkg3Path, "Test_None", proc.DefaultTimeout, reportKinds, nil,
+	)
+	chk.Err(err, errs.ErrNoTestToRun.Error())
+
+	f := chk.CreateTmpFile(nil)
+	_, _, err = runReport(f, pkgLabel, proc.DefaultTimeout, reportKinds, nil)
+	chk.Err(err, errs.ErrInvalidDirectory.Error())
+}
+
+func Test_Report_Count(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	passed, failed, skipped, err := Count(
+		tstpkg3Path, ".", proc.DefaultTimeout,
+	)
+	chk.NoErr(err)
+	chk.Int(passed, 4)
+	chk.Int(failed, 2)
+	chk.Int(skipped, 1)
+
+	_, _, _, err = Count(
+		"."+sep+"testdata"+sep+"tstbroken", ".", proc.DefaultTimeout,
+	)
+	chk.Err(err, ""+
+		errs.ErrBuildFailed.Error()+": go test -json "+
+		"github.com/dancsecs/gotomd/internal/gotest/testdata/tstbroken\n"+
+		"# github.com/dancsecs/gotomd/internal/gotest/testdata/"+
+		"tstbroken_test [github.com/dancsecs/gotomd/internal/gotest/"+
+		"testdata/tstbroken.test]\n"+
+		"testdata/tstbroken/broken_test.go:6:8: undefined: notDefined\n"+
+		"FAIL\tgithub.com/dancsecs/gotomd/internal/gotest/testdata/"+
+		"tstbroken [build failed]",
+	)
+}
@@ -0,0 +1,7 @@
+package tstbroken_test
+
+import "testing"
+
+func Test_Broken(t *testing.T) {
+	t.Log(notDefined)
+}
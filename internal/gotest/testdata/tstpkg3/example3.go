@@ -0,0 +1,7 @@
+// Package tstpkg3 provides an example with subtests for structured reports.
+package tstpkg3
+
+// Double returns twice the value.
+func Double(v int) int {
+	return v + v
+}
@@ -0,0 +1,46 @@
+package tstpkg3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/gotest/testdata/tstpkg3"
+)
+
+func Test_Double(t *testing.T) {
+	for _, tst := range []struct {
+		name string
+		v    int
+		exp  int
+	}{
+		{"zero", 0, 0},
+		{"one", 1, 2},
+		{"wrong", 2, 5},
+	} {
+		t.Run(tst.name, func(t *testing.T) {
+			t.Parallel()
+
+			time.Sleep(time.Duration(10-tst.v) * time.Millisecond)
+
+			if got := tstpkg3.Double(tst.v); got != tst.exp {
+				t.Errorf("Double(%d) = %d, want %d", tst.v, got, tst.exp)
+			}
+		})
+	}
+}
+
+func Test_Parallel(t *testing.T) {
+	t.Parallel()
+
+	t.Log("parallel output")
+}
+
+func Test_Skipped(t *testing.T) {
+	t.Skip("not supported")
+}
+
+func Test_Simple(t *testing.T) {
+	if tstpkg3.Double(3) != 6 {
+		t.Fatal("unexpected")
+	}
+}
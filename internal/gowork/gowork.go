@@ -0,0 +1,290 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gowork
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	workFileName = "go.work"
+	modFileName  = "go.mod"
+	workEnv      = "GOWORK"
+	workEnvOff   = "off"
+)
+
+// Workspace describes a go.work file and the modules it uses.
+type Workspace struct {
+	root    string
+	file    string
+	modules []string
+}
+
+// Root returns the absolute directory containing the go.work file.
+func (ws *Workspace) Root() string {
+	return ws.root
+}
+
+// File returns the absolute path of the go.work file.
+func (ws *Workspace) File() string {
+	return ws.file
+}
+
+// Modules returns the absolute directories of all modules listed in the
+// go.work use directives.
+func (ws *Workspace) Modules() []string {
+	return ws.modules
+}
+
+// Contains returns true if the path lies within the workspace root or any
+// of its modules (which may be outside the root as in "use ../other").
+func (ws *Workspace) Contains(path string) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+
+	if within(ws.root, absPath) {
+		return true
+	}
+
+	for _, modDir := range ws.modules {
+		if within(modDir, absPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+
+	return err == nil && filepath.IsLocal(rel)
+}
+
+func fileExists(path string) bool {
+	stat, err := os.Stat(path)
+
+	return err == nil && !stat.IsDir()
+}
+
+// searchUp returns the first directory (starting with dir and moving
+// towards the file system root) that contains the named file.
+func searchUp(dir, name string) string {
+	for {
+		if fileExists(filepath.Join(dir, name)) {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+
+		dir = parent
+	}
+}
+
+func load(wFile string) (*Workspace, error) {
+	data, err := os.ReadFile(wFile) //nolint:gosec // Ok.
+
+	var work *modfile.WorkFile
+
+	if err == nil {
+		work, err = modfile.ParseWork(wFile, data, nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: %q: %w", errs.ErrInvalidWorkspace, wFile, err,
+		)
+	}
+
+	ws := &Workspace{
+		root:    filepath.Dir(wFile),
+		file:    wFile,
+		modules: make([]string, 0, len(work.Use)),
+	}
+
+	for _, use := range work.Use {
+		modDir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(modDir) {
+			modDir = filepath.Join(ws.root, modDir)
+		}
+
+		ws.modules = append(ws.modules, filepath.Clean(modDir))
+	}
+
+	return ws, nil
+}
+
+// Find returns the workspace governing the directory or nil if the
+// directory is not part of a workspace.  The GOWORK environment variable is
+// honored in the same way as the go command.
+func Find(dir string) (*Workspace, error) {
+	wFile := os.Getenv(workEnv)
+
+	switch {
+	case wFile == workEnvOff:
+		return nil, nil //nolint:nilnil // No workspace is not an error.
+	case wFile != "":
+		wFile, _ = filepath.Abs(wFile)
+	default:
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Caller will wrap error.
+		}
+
+		root := searchUp(absDir, workFileName)
+		if root == "" {
+			return nil, nil //nolint:nilnil // No workspace is not an error.
+		}
+
+		wFile = filepath.Join(root, workFileName)
+	}
+
+	return load(wFile)
+}
+
+// ModuleRoot returns the absolute directory of the go.mod file governing
+// the directory or an empty string if it does not belong to a module.
+func ModuleRoot(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	return searchUp(absDir, modFileName), nil
+}
+
+// Locate returns the module directory a go command targeting the supplied
+// directory should be run from along with the directory expressed relative
+// to that module (prefixed by "./").  If the directory does not
+// belong to a module the directory is returned unchanged with an empty
+// module directory.
+func Locate(dir string) (string, string, error) {
+	absDir, err := filepath.Abs(dir)
+
+	var modDir, rel string
+
+	if err == nil {
+		modDir, err = ModuleRoot(absDir)
+	}
+
+	if err == nil && modDir == "" {
+		return "", dir, nil
+	}
+
+	if err == nil {
+		rel, err = filepath.Rel(modDir, absDir)
+	}
+
+	if err == nil && rel != "." {
+		rel = "." + string(os.PathSeparator) + rel
+	}
+
+	if err == nil {
+		return modDir, rel, nil
+	}
+
+	return "", "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// Env returns the environment settings a go command targeting the supplied
+// directory requires to resolve its workspace consistently regardless of
+// the directory the command is run from.
+func Env(dir string) ([]string, error) {
+	ws, err := Find(dir)
+
+	if err == nil && ws != nil {
+		return []string{workEnv + "=" + ws.File()}, nil
+	}
+
+	return nil, err
+}
+
+// IsWorkspaceRoot returns true if the directory directly contains a go.work
+// file.
+func IsWorkspaceRoot(dir string) bool {
+	return fileExists(filepath.Join(dir, workFileName))
+}
+
+// Relative returns the path relative to the current working directory
+// prefixed with "./" or "../" as appropriate.
+func Relative(path string) (string, error) {
+	cwd, err := os.Getwd()
+
+	var rel string
+
+	if err == nil {
+		rel, err = filepath.Rel(cwd, path)
+	}
+
+	if err == nil {
+		if !strings.HasPrefix(rel, "..") {
+			rel = "." + string(os.PathSeparator) + rel
+		}
+
+		return rel, nil
+	}
+
+	return "", err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// Target returns the directory a go command operating on the supplied
+// package directory should be run from, the package directory relative to
+// it and any environment settings the command requires.  An empty run
+// directory (with the package directory unchanged) is returned whenever the
+// current directory belongs to the same module as the package directory.
+func Target(dir string) (string, string, []string, error) {
+	var (
+		cwdModDir string
+		modDir    string
+		relDir    string
+		env       []string
+		err       error
+	)
+
+	cwdModDir, err = ModuleRoot(".")
+
+	if err == nil {
+		modDir, relDir, err = Locate(dir)
+	}
+
+	if err == nil {
+		env, err = Env(dir)
+	}
+
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	if modDir == "" || modDir == cwdModDir {
+		return "", dir, env, nil
+	}
+
+	return modDir, relDir, env, nil
+}
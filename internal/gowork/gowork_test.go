@@ -0,0 +1,207 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package gowork_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gowork"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+const sep = string(os.PathSeparator)
+
+// setupWorkspace creates the following layout returning the root:
+//
+//	root/ws/go.work      (use ./modA ./modB ../outside)
+//	root/ws/modA/go.mod
+//	root/ws/modA/pkg/
+//	root/ws/modB/go.mod
+//	root/outside/go.mod
+//	root/loose/
+func setupWorkspace(chk *sztest.Chk) string {
+	chk.T().Helper()
+
+	chk.DelEnv("GOWORK")
+
+	root := chk.CreateTmpDir()
+	ws := chk.CreateTmpSubDir("ws")
+	modA := chk.CreateTmpSubDir("ws", "modA")
+	_ = chk.CreateTmpSubDir("ws", "modA", "pkg")
+	modB := chk.CreateTmpSubDir("ws", "modB")
+	outside := chk.CreateTmpSubDir("outside")
+	_ = chk.CreateTmpSubDir("loose")
+
+	_ = chk.CreateTmpFileAs(ws, "go.work", []byte(""+
+		"go 1.25\n"+
+		"\n"+
+		"use (\n"+
+		"\t./modA\n"+
+		"\t./modB\n"+
+		"\t../outside\n"+
+		")\n",
+	))
+	_ = chk.CreateTmpFileAs(modA, "go.mod", []byte("module a\n"))
+	_ = chk.CreateTmpFileAs(modB, "go.mod", []byte("module b\n"))
+	_ = chk.CreateTmpFileAs(outside, "go.mod", []byte("module o\n"))
+
+	return root
+}
+
+func Test_GoWork_Find(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	root := setupWorkspace(chk)
+
+	ws, err := gowork.Find(filepath.Join(root, "ws", "modA", "pkg"))
+	chk.NoErr(err)
+	chk.NotNil(ws)
+	chk.Str(ws.Root(), filepath.Join(root, "ws"))
+	chk.Str(ws.File(), filepath.Join(root, "ws", "go.work"))
+	chk.StrSlice(
+		ws.Modules(),
+		[]string{
+			filepath.Join(root, "ws", "modA"),
+			filepath.Join(root, "ws", "modB"),
+			filepath.Join(root, "outside"),
+		},
+	)
+
+	chk.True(ws.Contains(filepath.Join(root, "ws", "modB")))
+	chk.True(ws.Contains(filepath.Join(root, "outside")))
+	chk.False(ws.Contains(filepath.Join(root, "loose")))
+
+	ws, err = gowork.Find(filepath.Join(root, "loose"))
+	chk.NoErr(err)
+	chk.Nil(ws)
+
+	chk.SetEnv("GOWORK", "off")
+
+	ws, err = gowork.Find(filepath.Join(root, "ws", "modA"))
+	chk.NoErr(err)
+	chk.Nil(ws)
+
+	chk.SetEnv("GOWORK", filepath.Join(root, "ws", "go.work"))
+
+	ws, err = gowork.Find(filepath.Join(root, "loose"))
+	chk.NoErr(err)
+	chk.NotNil(ws)
+	chk.Str(ws.Root(), filepath.Join(root, "ws"))
+}
+
+func Test_GoWork_FindInvalid(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	root := setupWorkspace(chk)
+	bad := chk.CreateTmpSubDir("bad")
+	_ = chk.CreateTmpFileAs(bad, "go.work", []byte("use (\n"))
+
+	ws, err := gowork.Find(bad)
+	chk.Nil(ws)
+	chk.Err(
+		err,
+		chk.ErrChain(
+			errs.ErrInvalidWorkspace,
+			"\""+filepath.Join(root, "bad", "go.work")+"\"",
+			filepath.Join(root, "bad", "go.work")+":2: syntax error"+
+				" (unterminated block started at "+
+				filepath.Join(root, "bad", "go.work")+":1:1)",
+		),
+	)
+}
+
+func Test_GoWork_ModuleRoot(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	root := setupWorkspace(chk)
+
+	modDir, err := gowork.ModuleRoot(filepath.Join(root, "ws", "modA", "pkg"))
+	chk.NoErr(err)
+	chk.Str(modDir, filepath.Join(root, "ws", "modA"))
+
+	modDir, err = gowork.ModuleRoot(filepath.Join(root, "loose"))
+	chk.NoErr(err)
+	chk.Str(modDir, "")
+
+	chk.True(gowork.IsWorkspaceRoot(filepath.Join(root, "ws")))
+	chk.False(gowork.IsWorkspaceRoot(filepath.Join(root, "ws", "modA")))
+}
+
+func Test_GoWork_Locate(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	root := setupWorkspace(chk)
+
+	t.Chdir(filepath.Join(root, "ws", "modB"))
+
+	modDir, relDir, err := gowork.Locate("../modA/pkg")
+	chk.NoErr(err)
+	chk.Str(modDir, filepath.Join(root, "ws", "modA"))
+	chk.Str(relDir, "."+sep+"pkg")
+
+	modDir, relDir, err = gowork.Locate("../../loose")
+	chk.NoErr(err)
+	chk.Str(modDir, "")
+	chk.Str(relDir, "../../loose")
+
+	env, err := gowork.Env(".")
+	chk.NoErr(err)
+	chk.StrSlice(
+		env,
+		[]string{"GOWORK=" + filepath.Join(root, "ws", "go.work")},
+	)
+
+	rel, err := gowork.Relative(filepath.Join(root, "outside"))
+	chk.NoErr(err)
+	chk.Str(rel, ".."+sep+".."+sep+"outside")
+
+	rel, err = gowork.Relative(filepath.Join(root, "ws", "modB", "sub"))
+	chk.NoErr(err)
+	chk.Str(rel, "."+sep+"sub")
+}
+
+func Test_GoWork_Target(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	root := setupWorkspace(chk)
+	wsEnv := []string{"GOWORK=" + filepath.Join(root, "ws", "go.work")}
+
+	t.Chdir(filepath.Join(root, "ws", "modA"))
+
+	runDir, relDir, env, err := gowork.Target("./pkg")
+	chk.NoErr(err)
+	chk.Str(runDir, "")
+	chk.Str(relDir, "./pkg")
+	chk.StrSlice(env, wsEnv)
+
+	runDir, relDir, env, err = gowork.Target("../modB")
+	chk.NoErr(err)
+	chk.Str(runDir, filepath.Join(root, "ws", "modB"))
+	chk.Str(relDir, ".")
+	chk.StrSlice(env, wsEnv)
+}
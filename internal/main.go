@@ -26,8 +26,12 @@ import (
 	"os"
 
 	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/gotomd/internal/config"
 	"github.com/dancsecs/gotomd/internal/expand"
+	"github.com/dancsecs/gotomd/internal/gocall"
 	"github.com/dancsecs/gotomd/internal/gopkg"
+	"github.com/dancsecs/gotomd/internal/gorun"
+	"github.com/dancsecs/gotomd/internal/proc"
 	"github.com/dancsecs/gotomd/internal/update"
 	"github.com/dancsecs/szlog"
 )
@@ -37,6 +41,8 @@ func processGoFiles(filesToProcess []string) error {
 
 	for i, mi := len(filesToProcess)-1, 0; i >= mi && err == nil; i-- {
 		gopkg.Reset()
+		gocall.Reset()
+		config.Reset()
 
 		err = expand.Process(filesToProcess[i])
 	}
@@ -49,6 +55,8 @@ func processMDFiles(filesToProcess []string) error {
 
 	for i, mi := len(filesToProcess)-1, 0; i >= mi && err == nil; i-- {
 		gopkg.Reset()
+		gocall.Reset()
+		config.Reset()
 
 		err = expand.Process(filesToProcess[i])
 	}
@@ -77,8 +85,17 @@ func Main() int {
 		}()
 	}
 
+	// Stop any running commands if interrupted.
+	stopInterrupt := proc.HandleInterrupt()
+	defer stopInterrupt()
+
+	// Remove any binaries built for run directives.
+	defer gorun.Reset()
+
 	err = args.Process()
 
+	proc.SetTimeout(args.Timeout())
+
 	if args.ShowLicense() {
 		szlog.Say0(LicenseCopyright, "\n")
 	}
@@ -41,7 +41,8 @@ var usage = []string{
 		"[-l | --license]",
 	"                   [-h | --help] [-f | --force] [-u | --uptodate]",
 	"                   [-o | --output <dir>] [-p | --permission <perm>]",
-	"                   [path ...]",
+	"                   [--tags <tags>] [--goos <os>] [--goarch <arch>]",
+	"                   [--timeout <duration>] [path ...]",
 	"",
 	"Synchronize Go package and GitHub style README.md documentation by embedding",
 	"Go documentation, source code, test and command output directly from the Go",
@@ -79,11 +80,29 @@ var usage = []string{
 	"",
 	"        (can only set RW bits).",
 	"",
+	"    [--tags <tags>]",
+	"        Comma separated build tags used when loading packages for",
+	"        documentation. Directives may override this with a 'tags=' option.",
+	"",
+	"    [--goos <os>]",
+	"        Target operating system (GOOS) used when loading packages for",
+	"        documentation. Directives may override this with a 'goos=' option.",
+	"",
+	"    [--goarch <arch>]",
+	"        Target architecture (GOARCH) used when loading packages for",
+	"        documentation. Directives may override this with a 'goarch=' option.",
+	"",
+	"    [--timeout <duration>]",
+	"        Maximum time (such as '90s' or '5m') allowed for each command run or",
+	"        package load. Directives may override this with a 'timeout=' option.",
+	"        Defaults to '10m'.",
+	"",
 	"    [path ...]",
 	"        Specific template files (named like '.*.gtm.md' or '.*.gtm.go') or a",
 	"        directory which will be searched for all matching template files.",
-	"        All subdirectories may be searched by using the special './...'",
-	"        path. It defaults to search the current directory: '.'",
+	"        All subdirectories may be searched by using the special './...' path",
+	"        which also follows all modules used by a go.work file in the",
+	"        directory. It defaults to search the current directory: '.'",
 }
 
 //nolint:gosec // Ok.
@@ -0,0 +1,182 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package normalize
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+)
+
+type rule struct {
+	match   *regexp.Regexp
+	replace string
+}
+
+// Built in rules replacing noise independent of the local machine.
+//
+//nolint:goCheckNoGlobals // Ok.
+var fixedRules = []config.Rule{
+	// "2024-03-01T12:34:56.789Z", "2024-03-01 12:34:56 +0000".
+	{
+		Match: `\b\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}` +
+			`(?:\.\d+)?(?:Z|\s?[+-]\d{2}:?\d{2})?\b`,
+		Replace: "<timestamp>",
+	},
+	// "1.234s", "12.5ms" (whole durations such as "5s" are kept).
+	{
+		Match:   `\b\d+\.\d+(ns|µs|us|ms|s)\b`,
+		Replace: "0.0${1}",
+	},
+	// "pid 1234", "PID: 1234", "pid=1234".
+	{
+		Match:   `(?i)\b(pid[\s:=]+)\d+\b`,
+		Replace: "${1}<pid>",
+	},
+}
+
+// minHostLen is the length below which a host name is too likely to occur
+// as an ordinary word to be replaced.
+const minHostLen = 4
+
+// hostRules returns the rule replacing the host name wherever it appears as
+// a whole word.  No rule is returned for "localhost" or short names.
+func hostRules(host string) []config.Rule {
+	if len(host) < minHostLen || host == "localhost" {
+		return nil
+	}
+
+	return []config.Rule{{
+		Match:   `\b` + regexp.QuoteMeta(host) + `\b`,
+		Replace: "<hostname>",
+	}}
+}
+
+// BuiltinRules returns the built in rules for common noise including those
+// replacing the local machine's temporary directory, home directory and
+// host name.
+func BuiltinRules() []config.Rule {
+	rules := append([]config.Rule(nil), fixedRules...)
+
+	// Randomly named entries ("/tmp/go-build1234") in the temporary
+	// directory.
+	tmp := filepath.Clean(os.TempDir())
+	rules = append(rules, config.Rule{
+		Match: regexp.QuoteMeta(tmp) +
+			regexp.QuoteMeta(string(os.PathSeparator)) +
+			`[^\s/\\]*\d{4,}[^\s/\\]*`,
+		Replace: "$$TMPDIR",
+	})
+
+	home, err := os.UserHomeDir()
+	if err == nil && filepath.Clean(home) != string(os.PathSeparator) {
+		rules = append(rules, config.Rule{
+			Match:   regexp.QuoteMeta(filepath.Clean(home)) + `\b`,
+			Replace: "~",
+		})
+	}
+
+	host, err := os.Hostname()
+	if err == nil {
+		rules = append(rules, hostRules(host)...)
+	}
+
+	return rules
+}
+
+func compile(path string, rules []config.Rule) ([]rule, error) {
+	compiled := make([]rule, 0, len(rules))
+
+	for _, r := range rules {
+		re, err := regexp.Compile(r.Match)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: normalize rule %q: %w",
+				errs.ErrInvalidConfig, path, r.Match, err,
+			)
+		}
+
+		compiled = append(compiled, rule{match: re, replace: r.Replace})
+	}
+
+	return compiled, nil
+}
+
+// load returns the built in rules (unless disabled) followed by the rules
+// from the project configuration applying to the current directory.
+func load() ([]rule, error) {
+	var (
+		cfg   *config.Config
+		rules []config.Rule
+	)
+
+	cfg, err := config.Load(".")
+
+	if err == nil {
+		if cfg.BuiltinRules() {
+			rules = BuiltinRules()
+		}
+
+		rules = append(rules, cfg.Normalize.Rules...)
+	}
+
+	if err == nil {
+		return compile(cfg.Path(), rules)
+	}
+
+	return nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+func apply(rules []rule, text string) string {
+	for _, r := range rules {
+		text = r.match.ReplaceAllString(text, r.replace)
+	}
+
+	return text
+}
+
+// String returns the text with every rule applied in turn.
+func String(text string) (string, error) {
+	rules, err := load()
+	if err != nil {
+		return "", err
+	}
+
+	return apply(rules, text), nil
+}
+
+// Lines returns the lines with every rule applied in turn to each.
+func Lines(lines []cmds.Line) ([]cmds.Line, error) {
+	rules, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]cmds.Line, len(lines))
+
+	for i, line := range lines {
+		res[i] = cmds.Line{Stderr: line.Stderr, Text: apply(rules, line.Text)}
+	}
+
+	return res, nil
+}
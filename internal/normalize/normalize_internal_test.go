@@ -0,0 +1,48 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package normalize
+
+import (
+	"testing"
+
+	"github.com/dancsecs/sztestlog"
+)
+
+func Test_Normalize_HostRules(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	chk.Int(len(hostRules("localhost")), 0)
+	chk.Int(len(hostRules("vm")), 0)
+	chk.Int(len(hostRules("")), 0)
+
+	rules, err := compile("", hostRules("buildbox-01.lan"))
+	chk.NoErr(err)
+	chk.Str(
+		apply(rules, "ssh dev@buildbox-01.lan: buildbox-01.lan.old"),
+		"ssh dev@<hostname>: <hostname>.old",
+	)
+
+	rules, err = compile("", hostRules("devbox"))
+	chk.NoErr(err)
+	chk.Str(
+		apply(rules, "devbox (devboxes, mydevbox) //devbox:22"),
+		"<hostname> (devboxes, mydevbox) //<hostname>:22",
+	)
+}
@@ -0,0 +1,150 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package normalize_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/sztest"
+	"github.com/dancsecs/sztestlog"
+)
+
+func setupConfig(t *testing.T, chk *sztest.Chk, cfg string) string {
+	t.Helper()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+
+	if cfg != "" {
+		_ = chk.CreateTmpFileAs(tstDir, config.FileName, []byte(cfg))
+	}
+
+	t.Chdir(tstDir)
+	config.Reset()
+	chk.PushPostReleaseFunc(func() error {
+		config.Reset()
+
+		return nil
+	})
+
+	return tstDir
+}
+
+func Test_Normalize_Builtin(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_ = setupConfig(t, chk, "")
+
+	for i, tst := range []struct {
+		text string
+		exp  string
+	}{
+		/* Idx:  0 */ {"started 2024-03-01T12:34:56.789Z ok",
+			"started <timestamp> ok"},
+		/* Idx:  1 */ {"at 2024-03-01 12:34:56 +0000.",
+			"at <timestamp>."},
+		/* Idx:  2 */ {"took 1.234s (12.5ms) wait 5s",
+			"took 0.0s (0.0ms) wait 5s"},
+		/* Idx:  3 */ {"pid 1234, PID: 42 pid=7 rapid 9",
+			"pid <pid>, PID: <pid> pid=<pid> rapid 9"},
+		/* Idx:  4 */ {"version 1.2.3", "version 1.2.3"},
+		/* Idx:  5 */ {filepath.Join(os.TempDir(), "go-build123456", "x"),
+			"$TMPDIR" + string(os.PathSeparator) + "x"},
+		/* Idx:  6 */ {filepath.Join(os.TempDir(), "cache"),
+			filepath.Join(os.TempDir(), "cache")},
+	} {
+		got, err := normalize.String(tst.text)
+		chk.NoErr(err)
+		chk.Str(got, tst.exp, "Idx: ", i)
+	}
+
+	home, err := os.UserHomeDir()
+	if err == nil && home != string(os.PathSeparator) {
+		got, err := normalize.String(filepath.Join(home, "notes.txt"))
+		chk.NoErr(err)
+		chk.Str(got, filepath.Join("~", "notes.txt"))
+	}
+
+	host, err := os.Hostname()
+	if err == nil && len(host) >= 4 && host != "localhost" {
+		got, err := normalize.String("connected to " + host + ".")
+		chk.NoErr(err)
+		chk.Str(got, "connected to <hostname>.")
+	}
+}
+
+func Test_Normalize_Configured(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_ = setupConfig(t, chk, `{"normalize": {"rules": [
+		{"match": "v(\\d+)\\.\\d+\\.\\d+", "replace": "v${1}.x.y"},
+		{"match": "^secret=.*$", "replace": "secret=REDACTED"}
+	]}}`)
+
+	got, err := normalize.String("gotomd v1.4.2 took 1.5s")
+	chk.NoErr(err)
+	chk.Str(got, "gotomd v1.x.y took 0.0s")
+
+	lines, err := normalize.Lines([]cmds.Line{
+		{Stderr: false, Text: "secret=hunter2"},
+		{Stderr: true, Text: "version v2.0.1"},
+	})
+	chk.NoErr(err)
+	chk.Str(cmds.Text(lines, nil, "E: "), "secret=REDACTED\nE: version v2.x.y")
+}
+
+func Test_Normalize_BuiltinDisabled(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	_ = setupConfig(t, chk, `{"normalize": {"builtin": false}}`)
+
+	got, err := normalize.String("took 1.5s")
+	chk.NoErr(err)
+	chk.Str(got, "took 1.5s")
+}
+
+func Test_Normalize_InvalidRule(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := setupConfig(t, chk,
+		`{"normalize": {"rules": [{"match": "(", "replace": ""}]}}`,
+	)
+
+	_, err := normalize.String("text")
+	chk.Err(err, ""+
+		errs.ErrInvalidConfig.Error()+": "+
+		filepath.Join(tstDir, config.FileName)+
+		`: normalize rule "(": error parsing regexp: `+
+		"missing closing ): `(`",
+	)
+
+	lines, err := normalize.Lines(nil)
+	chk.NotNil(err)
+	chk.True(lines == nil)
+}
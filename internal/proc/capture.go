@@ -0,0 +1,87 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package proc
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+)
+
+// capture collects the lines written to a command's standard output and
+// standard error in the order they were written.
+type capture struct {
+	mu      sync.Mutex
+	lines   []cmds.Line
+	partial [2][]byte
+}
+
+type streamWriter struct {
+	c      *capture
+	stderr bool
+}
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	w.c.mu.Lock()
+	defer w.c.mu.Unlock()
+
+	stream := 0
+	if w.stderr {
+		stream = 1
+	}
+
+	buf := append(w.c.partial[stream], p...)
+
+	for {
+		idx := bytes.IndexByte(buf, '\n')
+		if idx < 0 {
+			break
+		}
+
+		w.c.lines = append(w.c.lines, cmds.Line{
+			Stderr: w.stderr,
+			Text:   string(buf[:idx]),
+		})
+		buf = buf[idx+1:]
+	}
+
+	w.c.partial[stream] = buf
+
+	return len(p), nil
+}
+
+// result returns all lines including any final unterminated ones.
+func (c *capture) result() []cmds.Line {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lines := c.lines
+
+	for stream, partial := range c.partial {
+		if len(partial) > 0 {
+			lines = append(lines, cmds.Line{
+				Stderr: stream == 1,
+				Text:   string(partial),
+			})
+		}
+	}
+
+	return lines
+}
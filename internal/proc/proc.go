@@ -0,0 +1,202 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package proc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"golang.org/x/tools/go/packages"
+)
+
+// DefaultTimeout is used until overridden by SetTimeout.
+const DefaultTimeout = 10 * time.Minute
+
+// How long to wait for pipes held open by orphaned descendants once the
+// command itself has been stopped.
+const waitDelay = 2 * time.Second
+
+//nolint:goCheckNoGlobals // Ok.
+var (
+	rootCtx = context.Background()
+	timeout = DefaultTimeout
+)
+
+// SetTimeout sets the default timeout for commands and package loading.
+func SetTimeout(d time.Duration) {
+	timeout = d
+}
+
+// Timeout returns the default timeout for commands and package loading.
+func Timeout() time.Duration {
+	return timeout
+}
+
+// HandleInterrupt arranges for all running commands to be stopped if gotomd
+// receives an interrupt (Ctrl-C) or termination signal.  The returned
+// function restores the default signal handling.
+func HandleInterrupt() func() {
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt, syscall.SIGTERM,
+	)
+	rootCtx = ctx
+
+	return func() {
+		stop()
+
+		rootCtx = context.Background()
+	}
+}
+
+// Context returns a context that is done when the timeout expires or gotomd
+// is interrupted.
+func Context(d time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(rootCtx, d)
+}
+
+// Check returns an error identifying the operation if the context ended
+// because of its timeout or an interrupt.  Otherwise err is returned
+// unchanged.
+func Check(
+	ctx context.Context, d time.Duration, operation string, err error,
+) error {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf(
+			"%w: after %v: %s", errs.ErrCommandTimeout, d, operation,
+		)
+	case ctx.Err() != nil:
+		return fmt.Errorf("%w: %s", errs.ErrInterrupted, operation)
+	default:
+		return err
+	}
+}
+
+// Stopped returns true if the error was caused by a timeout or interrupt.
+func Stopped(err error) bool {
+	return errors.Is(err, errs.ErrCommandTimeout) ||
+		errors.Is(err, errs.ErrInterrupted)
+}
+
+// Cmd is an external command bounded by a timeout.
+type Cmd struct {
+	*exec.Cmd
+
+	ctx     context.Context //nolint:containedctx // Ok.
+	cancel  context.CancelFunc
+	timeout time.Duration
+}
+
+// Command returns the program and its arguments ready to be run in its own
+// process group bounded by the timeout.
+func Command(d time.Duration, name string, arg ...string) *Cmd {
+	ctx, cancel := Context(d)
+
+	c := exec.CommandContext(ctx, name, arg...)
+	c.WaitDelay = waitDelay
+	setProcessGroup(c)
+
+	return &Cmd{
+		Cmd:     c,
+		ctx:     ctx,
+		cancel:  cancel,
+		timeout: d,
+	}
+}
+
+func (c *Cmd) check(err error, partial string) error {
+	err = Check(c.ctx, c.timeout, cmds.QuoteWords(c.Args), err)
+
+	res := strings.TrimRight(partial, "\n")
+	if Stopped(err) && res != "" {
+		err = fmt.Errorf("%w\npartial output:\n%s", err, res)
+	}
+
+	return err
+}
+
+// Run starts the command and waits for it to complete.
+func (c *Cmd) Run() error {
+	defer c.cancel()
+
+	return c.check(c.Cmd.Run(), "")
+}
+
+// CombinedOutput runs the command returning its combined standard output
+// and standard error.  Output collected before a timeout or interrupt is
+// included in the error.
+func (c *Cmd) CombinedOutput() ([]byte, error) {
+	var out bytes.Buffer
+
+	defer c.cancel()
+
+	c.Stdout = &out
+	c.Stderr = &out
+
+	err := c.Cmd.Run()
+
+	return out.Bytes(), c.check(err, out.String())
+}
+
+// Lines runs the command returning the lines written to its standard output
+// and standard error (in the order written).  Output collected before a
+// timeout or interrupt is included in the error.
+func (c *Cmd) Lines() ([]cmds.Line, error) {
+	out := new(capture)
+
+	defer c.cancel()
+
+	c.Stdout = streamWriter{c: out, stderr: false}
+	c.Stderr = streamWriter{c: out, stderr: true}
+
+	err := c.Cmd.Run()
+	lines := out.result()
+
+	return lines, c.check(err, cmds.Text(lines, nil, ""))
+}
+
+// LoadPackages loads the packages matching the pattern bounded by the
+// default timeout.
+func LoadPackages(
+	cfg *packages.Config, pattern string,
+) ([]*packages.Package, error) {
+	ctx, cancel := Context(timeout)
+	defer cancel()
+
+	cfg.Context = ctx
+
+	pkgs, err := packages.Load(cfg, pattern)
+
+	err = Check(ctx, timeout, "loading packages: "+pattern, err)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	return pkgs, nil
+}
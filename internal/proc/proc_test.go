@@ -0,0 +1,158 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package proc_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/proc"
+	"github.com/dancsecs/sztestlog"
+	"golang.org/x/tools/go/packages"
+)
+
+func Test_Proc_Timeout(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer proc.SetTimeout(proc.DefaultTimeout)
+
+	chk.Dur(proc.Timeout(), proc.DefaultTimeout)
+	proc.SetTimeout(time.Minute)
+	chk.Dur(proc.Timeout(), time.Minute)
+}
+
+func Test_Proc_CombinedOutput(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	out, err := proc.Command(
+		time.Minute, "sh", "-c", "echo out; echo err >&2",
+	).CombinedOutput()
+	chk.NoErr(err)
+	chk.Str(string(out), "out\nerr\n")
+
+	out, err = proc.Command(
+		time.Minute, "sh", "-c", "echo failing; exit 2",
+	).CombinedOutput()
+	chk.Err(err, "exit status 2")
+	chk.False(proc.Stopped(err))
+	chk.Str(string(out), "failing\n")
+
+	err = proc.Command(time.Minute, "true").Run()
+	chk.NoErr(err)
+}
+
+func Test_Proc_TimeoutKillsProcessGroup(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	start := time.Now()
+
+	// The background sleep holds the output pipe open so this only returns
+	// promptly if all of the processes in the group are killed.
+	out, err := proc.Command(
+		200*time.Millisecond,
+		"sh", "-c", "echo started; sleep 10 & sleep 10",
+	).CombinedOutput()
+	chk.Err(err, ""+
+		errs.ErrCommandTimeout.Error()+
+		": after 200ms: sh -c 'echo started; sleep 10 & sleep 10'\n"+
+		"partial output:\n"+
+		"started",
+	)
+	chk.True(proc.Stopped(err))
+	chk.Str(string(out), "started\n")
+	chk.True(time.Since(start) < time.Second)
+
+	err = proc.Command(100*time.Millisecond, "sleep", "10").Run()
+	chk.Err(err, errs.ErrCommandTimeout.Error()+": after 100ms: sleep 10")
+}
+
+func Test_Proc_Interrupt(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	stop := proc.HandleInterrupt()
+	defer stop()
+
+	self, err := os.FindProcess(os.Getpid())
+	chk.NoErr(err)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		_ = self.Signal(os.Interrupt)
+	}()
+
+	err = proc.Command(time.Minute, "sleep", "10").Run()
+	chk.Err(err, errs.ErrInterrupted.Error()+": sleep 10")
+	chk.True(proc.Stopped(err))
+}
+
+func Test_Proc_LoadPackages(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer proc.SetTimeout(proc.DefaultTimeout)
+
+	cfg := new(packages.Config)
+	cfg.Mode = packages.NeedName
+
+	pkgs, err := proc.LoadPackages(cfg, ".")
+	chk.NoErr(err)
+	chk.Int(len(pkgs), 1)
+	chk.Str(pkgs[0].Name, "proc")
+
+	proc.SetTimeout(time.Nanosecond)
+
+	pkgs, err = proc.LoadPackages(cfg, ".")
+	chk.Err(err,
+		errs.ErrCommandTimeout.Error()+": after 1ns: loading packages: .",
+	)
+	chk.Int(len(pkgs), 0)
+}
+
+func Test_Proc_Lines(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	lines, err := proc.Command(
+		time.Minute, "sh", "-c",
+		"echo out1; sleep 0.05; echo err1 >&2; sleep 0.05; "+
+			"printf 'out2\\nout3'; sleep 0.05; printf 'partial' >&2",
+	).Lines()
+	chk.NoErr(err)
+	chk.Int(len(lines), 5)
+	chk.Str(cmds.Text(lines, nil, "E:"), "out1\nE:err1\nout2\nout3\nE:partial")
+
+	lines, err = proc.Command(
+		100*time.Millisecond, "sh", "-c", "echo started; sleep 10",
+	).Lines()
+	chk.Err(err, ""+
+		errs.ErrCommandTimeout.Error()+
+		": after 100ms: sh -c 'echo started; sleep 10'\n"+
+		"partial output:\n"+
+		"started",
+	)
+	chk.Int(len(lines), 1)
+}
@@ -0,0 +1,402 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package session
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dancsecs/gotomd/internal/cmds"
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/format"
+	"github.com/dancsecs/gotomd/internal/gorun"
+	"github.com/dancsecs/gotomd/internal/normalize"
+	"github.com/dancsecs/gotomd/internal/proc"
+)
+
+// Options that may appear on the session's first line.
+const (
+	optCopy    = "copy"
+	optTimeout = "timeout"
+)
+
+// Step prefixes.
+const (
+	shownPrefix  = "$ "
+	hiddenPrefix = "! "
+)
+
+// Built in command writing its standard input to a file.
+const writeCmd = "write"
+
+const (
+	writePerm = 0o644
+	dirPerm   = 0o750
+)
+
+type step struct {
+	hidden bool
+	cmd    string
+}
+
+type session struct {
+	dir     string
+	timeout time.Duration
+	copies  [][2]string
+	steps   []step
+}
+
+// parseOptions applies the options on the session's first line.
+func (s *session) parseOptions(line string) error {
+	words, err := cmds.SplitWords(line)
+
+	for i, mi := 0, len(words); i < mi && err == nil; i++ {
+		key, value, _ := strings.Cut(words[i], "=")
+
+		switch key {
+		case optCopy:
+			src, dst, found := strings.Cut(value, "=")
+			if !found {
+				dst = filepath.Base(src)
+			}
+
+			s.copies = append(s.copies, [2]string{src, dst})
+		case optTimeout:
+			var valid bool
+
+			s.timeout, valid = cmds.ParseDuration(value)
+			if !valid {
+				err = fmt.Errorf(
+					"%w: %s=%q", errs.ErrInvalidOption, key, value,
+				)
+			}
+		default:
+			err = fmt.Errorf("%w: %q", errs.ErrInvalidOption, words[i])
+		}
+	}
+
+	return err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// parse separates the session's first line of options from its steps.
+// Each step starts with "$ " (shown) or "! " (hidden) and continues until
+// the next step (or the end of any heredoc it starts).
+func parse(cmd string) (*session, error) {
+	s := &session{
+		dir:     "",
+		timeout: proc.Timeout(),
+		copies:  nil,
+		steps:   nil,
+	}
+
+	first, rest, _ := strings.Cut(cmd, "\n")
+
+	err := s.parseOptions(first)
+
+	for _, line := range strings.Split(rest, "\n") {
+		if err != nil {
+			break
+		}
+
+		last := len(s.steps) - 1
+		if last >= 0 && cmds.OpenHeredoc(s.steps[last].cmd) {
+			s.steps[last].cmd += "\n" + line
+
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, shownPrefix):
+			s.steps = append(s.steps, step{
+				hidden: false, cmd: line[len(shownPrefix):],
+			})
+		case strings.HasPrefix(line, hiddenPrefix):
+			s.steps = append(s.steps, step{
+				hidden: true, cmd: line[len(hiddenPrefix):],
+			})
+		case strings.TrimSpace(line) == "":
+		case last >= 0:
+			s.steps[last].cmd += "\n" + line
+		default:
+			err = fmt.Errorf("%w: %q", errs.ErrInvalidStep, line)
+		}
+	}
+
+	if err == nil && len(s.steps) == 0 {
+		err = errs.ErrMissingAction
+	}
+
+	if err == nil {
+		return s, nil
+	}
+
+	return nil, err
+}
+
+// copyFixture copies the local file or directory into the scratch
+// directory.
+func (s *session) copyFixture(src, dst string) error {
+	var (
+		stat os.FileInfo
+		data []byte
+		err  error
+	)
+
+	src, dst = filepath.Clean(src), filepath.Clean(dst)
+
+	if !filepath.IsLocal(src) || !filepath.IsLocal(dst) {
+		return fmt.Errorf(
+			"%w: %s=%s=%s", errs.ErrNotLocalDir, optCopy, src, dst,
+		)
+	}
+
+	dst = filepath.Join(s.dir, dst)
+
+	stat, err = os.Stat(src)
+
+	if err == nil && stat.IsDir() {
+		return os.CopyFS(dst, os.DirFS(src)) //nolint:wrapcheck // Ok.
+	}
+
+	if err == nil {
+		data, err = os.ReadFile(src) //nolint:gosec // Ok.
+	}
+
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(dst), dirPerm)
+	}
+
+	if err == nil {
+		err = os.WriteFile(dst, data, stat.Mode().Perm())
+	}
+
+	return err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// write implements the built in command writing the step's standard input
+// to the named file.
+func (s *session) write(
+	words []string, opts *cmds.RunOptions,
+) (string, error) {
+	if len(words) != 2 || !filepath.IsLocal(filepath.Clean(words[1])) {
+		return "", fmt.Errorf(
+			"%w: %s", errs.ErrInvalidStep, cmds.QuoteWords(words),
+		)
+	}
+
+	path := filepath.Join(s.dir, opts.Dir, words[1])
+
+	err := os.MkdirAll(filepath.Dir(path), dirPerm)
+	if err == nil {
+		err = os.WriteFile(path, opts.Stdin, writePerm)
+	}
+
+	if err != nil {
+		return "", err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	redirect := opts.Redirect()
+	cmdLine := strings.TrimSuffix(opts.Command([]string{"cat"}), redirect) +
+		" > " + cmds.QuoteWord(words[1]) + redirect
+
+	return cmdLine, nil
+}
+
+// program returns the path of the program to run for the step's first word
+// and the words displayed for it.  Relative packages are built and run
+// directly while all other programs must be allowed by the project
+// configuration's exec allow-list.
+func program(
+	word string, opts *cmds.RunOptions,
+) (string, []string, error) {
+	var (
+		dir    string
+		target string
+		path   string
+		cfg    *config.Config
+		err    error
+	)
+
+	stat, statErr := os.Stat(word)
+	isPkg := (statErr == nil && stat.IsDir()) || strings.HasSuffix(word, ".go")
+
+	if isPkg {
+		dir, target, err = cmds.ParseCmd(word)
+
+		if err == nil {
+			path, err = gorun.Binary(dir, target)
+		}
+
+		if err == nil {
+			name := strings.TrimSuffix(filepath.Base(path), ".exe")
+
+			return path, opts.Program(name), nil
+		}
+
+		return "", nil, err //nolint:wrapcheck // Caller will wrap error.
+	}
+
+	cfg, err = config.Load(".")
+
+	if err == nil && !cfg.ExecAllowed(word) {
+		err = fmt.Errorf("%w: %q (add it to exec.allow in %s)",
+			errs.ErrExecNotAllowed, word, config.FileName,
+		)
+	}
+
+	path = word
+	if err == nil && strings.ContainsRune(word, os.PathSeparator) {
+		// Run from the template's directory not the scratch directory.
+		path, err = filepath.Abs(word)
+	}
+
+	if err == nil {
+		return path, opts.Program(word), nil
+	}
+
+	return "", nil, err //nolint:wrapcheck // Caller will wrap error.
+}
+
+// run executes the step in the scratch directory returning the command
+// line displayed and its output.
+func (s *session) run(st step) (string, string, error) {
+	var (
+		exitErr *exec.ExitError
+		words   []string
+		opts    *cmds.RunOptions
+		path    string
+		prog    []string
+		lines   []cmds.Line
+		cmdLine string
+		code    int
+		err     error
+	)
+
+	words, opts, err = cmds.ParseRunCmdIn(s.dir, st.cmd, s.timeout)
+
+	if err == nil && len(words) == 0 {
+		err = fmt.Errorf("%w: %q", errs.ErrInvalidStep, st.cmd)
+	}
+
+	if err == nil && words[0] == writeCmd {
+		cmdLine, err = s.write(words, opts)
+
+		return cmdLine, "", err
+	}
+
+	if err == nil {
+		path, prog, err = program(words[0], opts)
+	}
+
+	if err == nil {
+		c := proc.Command(opts.Timeout, path, words[1:]...)
+		c.Dir = filepath.Join(s.dir, opts.Dir)
+		c.Env = append(os.Environ(), opts.Env...)
+		c.Stdin = bytes.NewReader(opts.Stdin)
+
+		lines, err = c.Lines()
+		if errors.As(err, &exitErr) {
+			code, err = exitErr.ExitCode(), nil
+		}
+	}
+
+	if err == nil {
+		lines, err = normalize.Lines(lines)
+	}
+
+	if err == nil {
+		cmdLine = opts.Command(append(prog, words[1:]...))
+		err = opts.Check(cmdLine, lines, code)
+	}
+
+	if err == nil {
+		return cmdLine, opts.Transcript(lines, code), nil
+	}
+
+	return "", "", err
+}
+
+// transcript runs the session's steps in sequence after copying any
+// fixtures into the scratch directory returning the shown steps and their
+// output.
+func (s *session) transcript() (string, error) {
+	var (
+		transcript []string
+		cmdLine    string
+		out        string
+		err        error
+	)
+
+	for i, mi := 0, len(s.copies); i < mi && err == nil; i++ {
+		err = s.copyFixture(s.copies[i][0], s.copies[i][1])
+	}
+
+	for i, mi := 0, len(s.steps); i < mi && err == nil; i++ {
+		cmdLine, out, err = s.run(s.steps[i])
+
+		if err == nil && !s.steps[i].hidden {
+			transcript = append(transcript, shownPrefix+cmdLine)
+			if out != "" {
+				transcript = append(transcript, out)
+			}
+		}
+	}
+
+	if err == nil {
+		return strings.Join(transcript, "\n"), nil
+	}
+
+	return "", err
+}
+
+// GetSession runs the session's steps in sequence within a new scratch
+// directory returning the transcript of the shown steps as it would appear
+// in a terminal.
+func GetSession(cmd string) (string, error) {
+	var (
+		s   *session
+		res string
+		err error
+	)
+
+	s, err = parse(cmd)
+
+	if err == nil {
+		s.dir, err = os.MkdirTemp("", "gotomd-session-")
+	}
+
+	if err == nil {
+		res, err = s.transcript()
+
+		_ = os.RemoveAll(s.dir)
+	}
+
+	if err == nil {
+		return format.Inline("console", res), nil
+	}
+
+	return "", err
+}
@@ -0,0 +1,170 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package session_test
+
+import (
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/config"
+	"github.com/dancsecs/gotomd/internal/errs"
+	"github.com/dancsecs/gotomd/internal/gorun"
+	"github.com/dancsecs/gotomd/internal/session"
+	"github.com/dancsecs/sztestlog"
+)
+
+const tool = "./testdata/tstnotes"
+
+func Test_GetSession_Transcript(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer gorun.Reset()
+
+	out, err := session.GetSession("\n" +
+		"! " + tool + " add hidden setup\n" +
+		"$ " + tool + " list\n" +
+		"$ stdin=<<END write sub/more.txt\n" +
+		"$ not a step\n" +
+		"END\n" +
+		"\n" +
+		"$ " + tool + " add\n" +
+		"  'second note'\n" +
+		"$ expect-exit=1 cwd=sub " + tool + " list\n",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"```console\n"+
+			"$ tstnotes list\n"+
+			"1. hidden setup\n"+
+			"$ cat > sub/more.txt <<'END'\n"+
+			"$ not a step\n"+
+			"END\n"+
+			"$ tstnotes add 'second note'\n"+
+			"added: second note\n"+
+			"$ cd sub && tstnotes list\n"+
+			"no notes\n"+
+			"$ echo $?\n"+
+			"1\n"+
+			"```",
+	)
+}
+
+func Test_GetSession_Fixtures(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer gorun.Reset()
+
+	out, err := session.GetSession("" +
+		"copy=./testdata/fixtures/notes.txt " +
+		"copy=./testdata/fixtures/more=a\n" +
+		"$ " + tool + " list\n" +
+		"$ cwd=a as=notes " + tool + " list\n",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"```console\n"+
+			"$ tstnotes list\n"+
+			"1. buy milk\n"+
+			"$ cd a && notes list\n"+
+			"1. water plants\n"+
+			"```",
+	)
+}
+
+func Test_GetSession_Errors(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	defer gorun.Reset()
+
+	for i, tst := range []struct {
+		cmd    string
+		expErr string
+	}{
+		/* Idx:  0 */ {"", errs.ErrMissingAction.Error()},
+		/* Idx:  1 */ {"hide=true\n$ " + tool + " list",
+			errs.ErrInvalidOption.Error() + `: "hide=true"`},
+		/* Idx:  2 */ {"timeout=soon\n$ " + tool + " list",
+			errs.ErrInvalidOption.Error() + `: timeout="soon"`},
+		/* Idx:  3 */ {"\n" + tool + " list",
+			errs.ErrInvalidStep.Error() + `: "` + tool + ` list"`},
+		/* Idx:  4 */ {"copy=../x\n$ " + tool + " list",
+			errs.ErrNotLocalDir.Error() + ": copy=../x=x"},
+		/* Idx:  5 */ {"\n$ stdin=<<EOF write ../x\nEOF",
+			errs.ErrInvalidStep.Error() + ": write ../x"},
+		/* Idx:  6 */ {"\n$ cwd=missing " + tool + " list",
+			errs.ErrInvalidDirectory.Error() + `: "missing"`},
+		/* Idx:  7 */ {"\n$ " + tool + " list",
+			errs.ErrUnexpectedExit.Error() +
+				": 1 (expected 0): tstnotes list\nno notes"},
+		/* Idx:  8 */ {"\n$ ",
+			errs.ErrInvalidStep.Error() + `: ""`},
+		/* Idx:  9 */ {"\n$ echo hello",
+			errs.ErrExecNotAllowed.Error() +
+				`: "echo" (add it to exec.allow in .gotomd.json)`},
+	} {
+		out, err := session.GetSession(tst.cmd)
+		chk.Err(err, tst.expErr, "Idx: ", i)
+		chk.Str(out, "", "Idx: ", i)
+	}
+}
+
+func Test_GetSession_Exec(t *testing.T) {
+	chk := sztestlog.CaptureNothing(t)
+	defer chk.Release()
+
+	tstDir := chk.CreateTmpDir()
+	_ = chk.CreateTmpFileAs(tstDir, "go.mod", []byte("module example\n"))
+	_ = chk.CreateTmpFileAs(tstDir, config.FileName,
+		[]byte(`{"exec": {"allow": ["ls", "cat"]}}`),
+	)
+
+	t.Chdir(tstDir)
+	config.Reset()
+
+	defer config.Reset()
+
+	out, err := session.GetSession("timeout=1m\n" +
+		"! stdin=<<EOF write b.txt\nbee\nEOF\n" +
+		"$ stdin=<<EOF write a.txt\nay\nEOF\n" +
+		"$ ls\n" +
+		"$ cat a.txt b.txt\n",
+	)
+	chk.NoErr(err)
+	chk.Str(
+		out,
+		""+
+			"```console\n"+
+			"$ cat > a.txt <<'EOF'\n"+
+			"ay\n"+
+			"EOF\n"+
+			"$ ls\n"+
+			"a.txt\n"+
+			"b.txt\n"+
+			"$ cat a.txt b.txt\n"+
+			"ay\n"+
+			"bee\n"+
+			"```",
+	)
+}
@@ -0,0 +1,51 @@
+// Provides a simple stateful example to test session transcripts.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const notesFile = "notes.txt"
+
+//nolint:forbidigo // Printing is ok.
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: tstnotes add text... | list")
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "add":
+		text := strings.Join(os.Args[2:], " ")
+
+		f, err := os.OpenFile(
+			notesFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600,
+		)
+		if err == nil {
+			_, err = fmt.Fprintln(f, text)
+			_ = f.Close()
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fmt.Println("added:", text)
+	case "list":
+		data, err := os.ReadFile(notesFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "no notes")
+			os.Exit(1)
+		}
+
+		for i, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			fmt.Printf("%d. %s\n", i+1, line)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, "unknown command:", os.Args[1])
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package testmod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dancsecs/gotomd/internal/args"
+	"github.com/dancsecs/sztest"
+)
+
+// Setup copies the module in the named testdata directory into a new
+// temporary directory and changes into it returning its path.  Any
+// GOWORK and GOFLAGS settings are removed so the module is built in
+// isolation.
+func Setup(t *testing.T, chk *sztest.Chk, fixture string) string {
+	t.Helper()
+
+	chk.DelEnv("GOWORK")
+	chk.DelEnv("GOFLAGS")
+
+	dir := chk.CreateTmpDir()
+	chk.NoErr(os.CopyFS(dir, os.DirFS(filepath.Join("testdata", fixture))))
+
+	t.Chdir(dir)
+
+	return dir
+}
+
+// Args processes the command line arguments restoring the defaults when
+// the test is released.
+func Args(chk *sztest.Chk, procArgs ...string) {
+	chk.T().Helper()
+
+	chk.SetArgs("noProgName", procArgs...)
+	chk.NoErr(args.Process())
+	chk.PushPostReleaseFunc(func() error {
+		args.Reset()
+
+		return nil
+	})
+}
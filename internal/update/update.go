@@ -78,7 +78,8 @@ func fileExists(fPath string) (bool, error) {
 // File creates or replaces an existing file with the provided data and
 // file permissions if and only if it has changed.  If changed and force is not
 // true then a message asking for confirmation is presented giving an
-// opportunity to review the changes.
+// opportunity to review the changes.  When only checking if the file is up
+// to date any volatile regions are ignored.
 //
 //nolint:cyclop,funlen  // Ok.
 func File(
@@ -120,7 +121,10 @@ func File(
 		}
 	}
 
-	if err == nil && oldData == data {
+	unchanged := oldData == data ||
+		checkUpToDate && stripVolatile(oldData) == stripVolatile(data)
+
+	if err == nil && unchanged {
 		szlog.Say1("No change: ", fPath, "\n")
 
 		return Unchanged, nil
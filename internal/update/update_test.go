@@ -126,6 +126,37 @@ func Test_Process_UpToDateWithUpdate(t *testing.T) {
 	)
 }
 
+func Test_Process_UpToDateVolatile(t *testing.T) {
+	chk := sztestlog.CaptureStdout(t)
+	defer chk.Release()
+
+	const (
+		begin = "<!--- " + update.VolatileBegin + " -->\n"
+		end   = "<!--- " + update.VolatileEnd + " -->\n"
+	)
+
+	file := chk.CreateTmpFile([]byte("abc\n" + begin + "1 ns/op\n" + end))
+
+	update.ResetUpToDate()
+
+	result, err := update.File(file, false, true, "abc\n"+begin+end, perm)
+
+	chk.NoErr(err)
+	chk.Int(int(result), int(update.Unchanged))
+	chk.True(update.IsUpToDate())
+
+	result, err = update.File(file, false, true, "def\n"+begin+end, perm)
+
+	chk.NoErr(err)
+	chk.Int(int(result), int(update.Cancelled))
+	chk.False(update.IsUpToDate())
+
+	chk.Stdout(
+		"No change: "+file,
+		"Would have updated: "+file,
+	)
+}
+
 func Test_Process_Forced_Update(t *testing.T) {
 	chk := sztestlog.CaptureStdout(t)
 	defer chk.Release()
@@ -0,0 +1,64 @@
+/*
+   Golang To Github Markdown Utility: gotomd
+   Copyright (C) 2026 Leslie Dancsecs
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package update
+
+import (
+	"strings"
+
+	"github.com/dancsecs/gotomd/internal/format"
+)
+
+// Markers delimiting a volatile region of generated content (such as
+// benchmark timings) that is ignored when checking if a file is up to date.
+const (
+	VolatileBegin = "gotomd:volatile:begin"
+	VolatileEnd   = "gotomd:volatile:end"
+)
+
+// Volatile brackets the content with markers so up to date checks ignore
+// it.
+func Volatile(content string) string {
+	return format.Comment(VolatileBegin) +
+		content + "\n" +
+		strings.TrimRight(format.Comment(VolatileEnd), "\n")
+}
+
+// stripVolatile removes the lines between volatile markers keeping the
+// markers themselves.
+func stripVolatile(data string) string {
+	var (
+		kept     []string
+		volatile bool
+	)
+
+	for _, line := range strings.Split(data, "\n") {
+		switch {
+		case strings.Contains(line, VolatileBegin):
+			volatile = true
+		case strings.Contains(line, VolatileEnd):
+			volatile = false
+		case volatile:
+			continue
+		}
+
+		kept = append(kept, line)
+	}
+
+	return strings.Join(kept, "\n")
+}